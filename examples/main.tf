@@ -12,12 +12,21 @@ provider "pingone" {
   client_secret   = var.p1_adminClientSecret
 	environment_id  = var.p1_adminEnvId
 	region          = var.p1_region
+
+	validate_attribute_references = true
 }
 
 data "pingone_environment" "admin_env" {
   name = "Administrators"
 }
 
+data "pingone_organization" "organization" {}
+
+data "pingone_environments" "sandboxes" {
+  name_prefix = "AAA"
+  type = "SANDBOX"
+}
+
 ## Get Roles
 data "pingone_role" "organisation_admin" {
   name = "Organization Admin"
@@ -38,6 +47,10 @@ data "pingone_role" "client_application_developer" {
 data "pingone_role" "identity_data_admin_ro" {
   name = "Identity Data Read Only"
 }
+
+data "pingone_roles" "population_scoped" {
+  applicable_to = "POPULATION"
+}
 ## End
 
 data "pingone_group" "test_group" {
@@ -53,8 +66,10 @@ resource "pingone_environment" "test" {
 
   license_id = var.p1_licenseId
 
-  default_population_name = "Default2"
-  default_population_description = "tbc"
+  default_population {
+    name = "Default2"
+    description = "tbc"
+  }
 
   product {
     type = "PING_ONE_BASE"
@@ -103,6 +118,21 @@ resource "pingone_user_role_assignment" "admin_role_assignment" {
   scope_type = "ENVIRONMENT"
 }
 
+resource "pingone_user_role_assignment" "org_admin_role_assignment" {
+  environment_id = data.pingone_environment.admin_env.id
+  user_id = "3944a587-a1aa-4378-9933-e9f9a2ad59fe" // My test user
+  role_id = data.pingone_role.organisation_admin.id
+  scope_id = data.pingone_organization.organization.id
+  scope_type = "ORGANIZATION"
+}
+
+data "pingone_users" "customers_a_enabled" {
+  environment_id = pingone_environment.test.environment_id
+
+  population_id = pingone_population.customers_a.id
+  enabled = true
+}
+
 resource "pingone_population" "customers_a" {
   environment_id = pingone_environment.test.environment_id
 
@@ -115,6 +145,19 @@ resource "pingone_population" "customers_b" {
 
   name = "Customers B"
   description = "WUT this is terraformed"
+
+  delete_users_on_destroy = true
+}
+
+data "pingone_populations" "customers" {
+  environment_id = pingone_environment.test.environment_id
+
+  name_prefix = "Customers"
+
+  depends_on = [
+    pingone_population.customers_a,
+    pingone_population.customers_b
+  ]
 }
 
 resource "pingone_group" "test_group" {
@@ -145,6 +188,93 @@ resource "pingone_schema_attribute" "test_attribute" {
   
 }
 
+data "pingone_schema_attributes" "custom" {
+  environment_id = pingone_environment.test.environment_id
+  schema_id = data.pingone_schema.attribute_schema.id
+
+  schema_type = "CUSTOM"
+}
+
+resource "pingone_schema_attribute_override" "mobile_phone" {
+  environment_id = pingone_environment.test.environment_id
+  schema_id = data.pingone_schema.attribute_schema.id
+
+  name = "mobilePhone"
+  display_name = "Mobile number"
+  required = true
+}
+
+resource "pingone_schema_attribute_override" "family_name" {
+  environment_id = pingone_environment.test.environment_id
+  schema_id = data.pingone_schema.attribute_schema.id
+
+  name = "name.family"
+  display_name = "Surname"
+}
+
+resource "pingone_schema_attribute" "tshirt_size" {
+  environment_id = pingone_environment.test.environment_id
+  schema_id = data.pingone_schema.attribute_schema.id
+
+  name = "tshirtSize"
+  display_name = "T-shirt size"
+
+  enumerated_values {
+    value = "S"
+    description = "Small"
+  }
+
+  enumerated_values {
+    value = "M"
+    description = "Medium"
+  }
+
+  enumerated_values {
+    value = "L"
+    description = "Large"
+  }
+}
+
+resource "pingone_schema_attribute" "work_address" {
+  environment_id = pingone_environment.test.environment_id
+  schema_id = data.pingone_schema.attribute_schema.id
+
+  name = "workAddress"
+  display_name = "Work address"
+  type = "COMPLEX"
+
+  sub_attribute {
+    name = "streetAddress"
+    display_name = "Street address"
+    required = true
+  }
+
+  sub_attribute {
+    name = "locality"
+    display_name = "Town or city"
+  }
+
+  sub_attribute {
+    name = "postalCode"
+    display_name = "Postal code"
+  }
+}
+
+resource "pingone_schema_attribute" "employee_number" {
+  environment_id = pingone_environment.test.environment_id
+  schema_id = data.pingone_schema.attribute_schema.id
+
+  name = "employeeNumber"
+  display_name = "Employee number"
+
+  regex_validation {
+    pattern = "E[0-9]{6}"
+    requirements = "Must be an E followed by six digits"
+    values_pattern_should_match = ["E123456"]
+    values_pattern_should_not_match = ["123456", "E12345"]
+  }
+}
+
 
 ### Application
 resource "pingone_application_oidc" "worker_app" {
@@ -192,6 +322,21 @@ resource "pingone_application_oidc" "oidc_web_app" {
 
 }
 
+resource "pingone_application_oidc" "oidc_web_app_2" {
+  environment_id = pingone_environment.test.environment_id
+
+  name = "Test App 2"
+  description = "Test app 2 description"
+  enabled = true
+
+  type = "WEB_APP"
+  grant_types = ["AUTHORIZATION_CODE"]
+  response_types = ["CODE"]
+  token_endpoint_authn_method = "CLIENT_SECRET_BASIC"
+
+  redirect_uris = ["https://localhost"]
+}
+
 data "pingone_application_oidc_secret" "oidc_web_app_secret" {
   environment_id = pingone_environment.test.environment_id
   application_id = pingone_application_oidc.oidc_web_app.id
@@ -267,6 +412,45 @@ resource "pingone_application_attribute_mapping" "username" {
   required = false
 }
 
+resource "pingone_application_attribute_mapping" "sub" {
+  environment_id = pingone_environment.test.environment_id
+  application_id = pingone_application_oidc.oidc_web_app.id
+
+  name = "sub"
+  value = "$${user.email}"
+  required = true
+}
+
+resource "pingone_application_attribute_mapping" "full_name" {
+  environment_id = pingone_environment.test.environment_id
+  application_id = pingone_application_oidc.oidc_web_app.id
+
+  name = "full_name"
+  value = "$${user.name.given + ' ' + user.name.family}"
+  required = false
+}
+
+resource "pingone_application_attribute_mappings" "oidc_web_app_claims" {
+  environment_id = pingone_environment.test.environment_id
+  application_id = pingone_application_oidc.oidc_web_app_2.id
+
+  mapping {
+    name = "email"
+    value = "$${user.email}"
+    required = true
+  }
+
+  mapping {
+    name = "given_name"
+    value = "$${user.name.given}"
+  }
+
+  mapping {
+    name = "family_name"
+    value = "$${user.name.family}"
+  }
+}
+
 resource "pingone_gateway" "pingfederate" {
   environment_id = pingone_environment.test.environment_id
 
@@ -274,6 +458,13 @@ resource "pingone_gateway" "pingfederate" {
   type = "PING_FEDERATE"
   description = "It's a PingFed Gateway"
   enabled = true
+
+  // Set once the gateway container has been deployed with the credential below
+  wait_for_instances = 0
+
+  timeouts {
+    update = "10m"
+  }
   
 }
 
@@ -281,6 +472,15 @@ resource "pingone_gateway_credential" "pingfederate" {
   environment_id = pingone_environment.test.environment_id
   gateway_id = pingone_gateway.pingfederate.id
 
+  // Change to rotate the credential.  `create_before_destroy` is required so that the new credential is issued
+  // before the one in use by the running gateway is revoked
+  rotation_trigger = {
+    rotated = "2021-10-01"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
 }
 
 resource "pingone_gateway_credential" "pingfederate1" {
@@ -289,6 +489,21 @@ resource "pingone_gateway_credential" "pingfederate1" {
 
 }
 
+data "pingone_gateway_credentials" "pingfederate" {
+  environment_id = pingone_environment.test.environment_id
+  gateway_id = pingone_gateway.pingfederate.id
+
+  depends_on = [
+    pingone_gateway_credential.pingfederate,
+    pingone_gateway_credential.pingfederate1
+  ]
+}
+
+data "pingone_gateway_instances" "pingfederate" {
+  environment_id = pingone_environment.test.environment_id
+  gateway_id = pingone_gateway.pingfederate.id
+}
+
 resource "pingone_gateway" "pingintelligence" {
   environment_id = pingone_environment.test.environment_id
 
@@ -299,6 +514,40 @@ resource "pingone_gateway" "pingintelligence" {
   
 }
 
+resource "pingone_gateway" "ldap" {
+  environment_id = pingone_environment.test.environment_id
+
+  name = "LDAP Gateway"
+  type = "LDAP"
+  description = "It's an LDAP Gateway"
+  enabled = true
+
+  bind_dn = "uid=pingone,dc=example,dc=com"
+  bind_password = var.ldap_bind_password
+  vendor = "PingDirectory"
+  connection_security = "TLS"
+  servers = ["ds1.example.com:636", "ds2.example.com:636"]
+  validate_tls_certificates = true
+
+  user_type {
+    name = "Employees"
+    password_authority = "LDAP"
+    search_base_dn = "ou=people,dc=example,dc=com"
+    ordered_correlation_attributes = ["uid", "mail"]
+    push_password_changes_to_ldap = true
+
+    new_user_lookup {
+      ldap_filter_pattern = "(|(uid=$${identifier})(mail=$${identifier}))"
+      population_id = pingone_population.customers_a.id
+
+      attribute_mapping {
+        name = "username"
+        value = "$${ldapAttributes.uid}"
+      }
+    }
+  }
+}
+
 resource "pingone_gateway_credential" "pingintelligence" {
   environment_id = pingone_environment.test.environment_id
   gateway_id = pingone_gateway.pingintelligence.id
@@ -311,8 +560,114 @@ data "pingone_application_system" "self_service" {
   type = "PING_ONE_SELF_SERVICE"
 }
 
+data "pingone_applications" "oidc_web_apps" {
+  environment_id = pingone_environment.test.environment_id
+
+  protocol = "OPENID_CONNECT"
+  type     = "WEB_APP"
+  enabled  = true
+}
+
+data "pingone_application" "self_service" {
+  environment_id = pingone_environment.test.environment_id
+  application_id = data.pingone_application_system.self_service.id
+}
+
 data "pingone_application_resource_grants" "self_service" {
   environment_id = pingone_environment.test.environment_id
   application_id = data.pingone_application_system.self_service.id
 
-}
\ No newline at end of file
+}
+resource "pingone_webhook" "siem" {
+  environment_id = pingone_environment.test.environment_id
+
+  name = "SIEM Webhook"
+  enabled = true
+
+  http_endpoint_url = "https://siem.example.com/pingone"
+  http_endpoint_headers = {
+    Authorization = "Splunk 00000000-0000-0000-0000-000000000000"
+  }
+
+  format = "SPLUNK"
+
+  filter_options {
+    included_action_types = ["USER.CREATED", "USER.DELETED"]
+    included_population_ids = [pingone_population.customers_a.id]
+  }
+}
+
+### Agreements
+resource "pingone_language" "fr" {
+  environment_id = pingone_environment.test.environment_id
+
+  locale = "fr-CA"
+}
+
+resource "pingone_language_update" "fr" {
+  environment_id = pingone_environment.test.environment_id
+  language_id = pingone_language.fr.id
+
+  enabled = true
+}
+
+resource "pingone_agreement" "terms" {
+  environment_id = pingone_environment.test.environment_id
+
+  name = "Terms of Service"
+  description = "Consumer terms of service"
+}
+
+resource "pingone_agreement_localization" "terms_fr" {
+  environment_id = pingone_environment.test.environment_id
+  agreement_id = pingone_agreement.terms.id
+
+  locale = pingone_language_update.fr.locale
+  display_name = "Conditions d'utilisation"
+}
+
+resource "pingone_agreement_revision" "terms_fr_v1" {
+  environment_id = pingone_environment.test.environment_id
+  agreement_id = pingone_agreement.terms.id
+  agreement_localization_id = pingone_agreement_localization.terms_fr.id
+
+  content_type = "text/html"
+  text = "<p>Conditions d'utilisation</p>"
+  effective_at = "2022-01-01T00:00:00Z"
+  require_reconsent = true
+}
+
+### Trusted email
+resource "pingone_trusted_email_domain" "notifications" {
+  environment_id = pingone_environment.test.environment_id
+
+  domain_name = "notifications.bxretail.org"
+}
+
+resource "pingone_trusted_email_address" "noreply" {
+  environment_id = pingone_environment.test.environment_id
+  email_domain_id = pingone_trusted_email_domain.notifications.id
+
+  email_address = "noreply@notifications.bxretail.org"
+}
+
+output "trusted_email_domain_ownership_records" {
+  value = pingone_trusted_email_domain.notifications.ownership_record
+}
+
+output "trusted_email_domain_dkim_records" {
+  value = pingone_trusted_email_domain.notifications.dkim_record
+}
+
+### Licenses
+data "pingone_license" "environment_license" {
+  license_id = var.p1_licenseId
+}
+
+data "pingone_licenses" "active" {
+  status = "ACTIVE"
+}
+
+output "environment_license_expires_at" {
+  value = data.pingone_license.environment_license.expires_at
+}
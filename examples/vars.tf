@@ -11,9 +11,12 @@ variable "p1_adminClientSecret" {
 }
 
 variable "p1_region" {
-  description = "The PingOne region to use.  Must be one of `EU`, `US`, `ASIA`, `CA`"
+  description = "The PingOne region to use.  Must be one of `EU`, `US` (or `NA`), `ASIA` (or `AP`), `CA`"
 }
 
 variable "p1_licenseId" {
   
-}
\ No newline at end of file
+}
+variable "ldap_bind_password" {
+  sensitive = true
+}
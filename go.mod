@@ -3,6 +3,7 @@ module terraform-provider-pingone
 go 1.15
 
 require (
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.7.0
 	github.com/patrickcping/pingone-go v0.0.0-20211015164909-1214fbc0ee7c
 	golang.org/x/net v0.0.0-20210813160813-60bc85c4be6d // indirect
@@ -0,0 +1,612 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// PingOne attribute mapping values are templates of `${...}` expressions written in a subset of the Spring Expression
+// Language.  The parser below only checks the syntax and collects the attribute paths that are referenced, e.g.
+// `${user.name.given + ' ' + user.name.family.toUpperCase()}` references `user.name.given` and `user.name.family`.
+// Evaluation is left to the platform.
+
+type attributeExpressionToken struct {
+	kind  string
+	value string
+	pos   int
+}
+
+const (
+	attributeExpressionTokenIdent  = "ident"
+	attributeExpressionTokenNumber = "number"
+	attributeExpressionTokenString = "string"
+	attributeExpressionTokenSymbol = "symbol"
+	attributeExpressionTokenEOF    = "eof"
+)
+
+// Symbols are matched longest first
+var attributeExpressionSymbols = []string{".?[", ".![", ".^[", ".$[", "?.", "?:", "==", "!=", "<=", ">=", "&&", "||", ".", ",", "(", ")", "[", "]", "{", "}", "#", "?", ":", "+", "-", "*", "/", "%", "!", "<", ">"}
+
+var attributeExpressionKeywordOperators = map[string]bool{"and": true, "or": true, "not": true, "eq": true, "ne": true, "lt": true, "gt": true, "le": true, "ge": true, "instanceof": true, "matches": true}
+
+var attributePathRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*(\.[A-Za-z][A-Za-z0-9_-]*)*$`)
+
+// Parses an attribute mapping value and returns the attribute paths referenced in its expressions
+func parseAttributeExpressionTemplate(value string) ([]string, error) {
+
+	references := make([]string, 0)
+
+	for i := 0; i < len(value); {
+		start := strings.Index(value[i:], "${")
+		if start < 0 {
+			break
+		}
+		start += i
+
+		end, err := attributeExpressionEnd(value, start+2)
+		if err != nil {
+			return nil, err
+		}
+
+		expression := value[start+2 : end]
+		if strings.TrimSpace(expression) == "" {
+			return nil, fmt.Errorf("empty expression at position %d", start)
+		}
+
+		expressionReferences, err := parseAttributeExpression(expression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid expression `${%s}` at position %d: %v", expression, start, err)
+		}
+
+		references = append(references, expressionReferences...)
+		i = end + 1
+	}
+
+	return references, nil
+}
+
+// Finds the closing brace of an expression, skipping braces in string literals and inline lists
+func attributeExpressionEnd(value string, from int) (int, error) {
+
+	depth := 0
+	var quote byte
+
+	for i := from; i < len(value); i++ {
+		c := value[i]
+
+		if quote != 0 {
+			if c == quote {
+				// Quotes are escaped by doubling them
+				if i+1 < len(value) && value[i+1] == quote {
+					i++
+					continue
+				}
+				quote = 0
+			}
+			continue
+		}
+
+		switch c {
+		case '\'', '"':
+			quote = c
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i, nil
+			}
+			depth--
+		}
+	}
+
+	if quote != 0 {
+		return 0, fmt.Errorf("unterminated string literal in the expression starting at position %d", from-2)
+	}
+
+	return 0, fmt.Errorf("the expression starting at position %d is missing a closing `}`", from-2)
+}
+
+func tokenizeAttributeExpression(expression string) ([]attributeExpressionToken, error) {
+
+	tokens := make([]attributeExpressionToken, 0)
+
+	for i := 0; i < len(expression); {
+		c := rune(expression[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+
+		case unicode.IsLetter(c) || c == '_' || c == '$':
+			start := i
+			for i < len(expression) && (unicode.IsLetter(rune(expression[i])) || unicode.IsDigit(rune(expression[i])) || expression[i] == '_' || expression[i] == '$') {
+				i++
+			}
+			tokens = append(tokens, attributeExpressionToken{kind: attributeExpressionTokenIdent, value: expression[start:i], pos: start})
+
+		case unicode.IsDigit(c):
+			start := i
+			for i < len(expression) && (unicode.IsDigit(rune(expression[i])) || expression[i] == '.') {
+				// A dot not followed by a digit is a method call on the number
+				if expression[i] == '.' && (i+1 >= len(expression) || !unicode.IsDigit(rune(expression[i+1]))) {
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, attributeExpressionToken{kind: attributeExpressionTokenNumber, value: expression[start:i], pos: start})
+
+		case c == '\'' || c == '"':
+			start := i
+			i++
+			closed := false
+			for i < len(expression) {
+				if rune(expression[i]) == c {
+					if i+1 < len(expression) && rune(expression[i+1]) == c {
+						i += 2
+						continue
+					}
+					i++
+					closed = true
+					break
+				}
+				i++
+			}
+			if !closed {
+				return nil, fmt.Errorf("unterminated string literal at position %d", start)
+			}
+			tokens = append(tokens, attributeExpressionToken{kind: attributeExpressionTokenString, value: expression[start:i], pos: start})
+
+		default:
+			matched := false
+			for _, symbol := range attributeExpressionSymbols {
+				if strings.HasPrefix(expression[i:], symbol) {
+					tokens = append(tokens, attributeExpressionToken{kind: attributeExpressionTokenSymbol, value: symbol, pos: i})
+					i += len(symbol)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character `%c` at position %d", c, i)
+			}
+		}
+	}
+
+	tokens = append(tokens, attributeExpressionToken{kind: attributeExpressionTokenEOF, pos: len(expression)})
+
+	return tokens, nil
+}
+
+type attributeExpressionParser struct {
+	tokens     []attributeExpressionToken
+	pos        int
+	references []string
+}
+
+func parseAttributeExpression(expression string) ([]string, error) {
+
+	tokens, err := tokenizeAttributeExpression(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &attributeExpressionParser{
+		tokens:     tokens,
+		references: make([]string, 0),
+	}
+
+	if err := p.parseExpression(); err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != attributeExpressionTokenEOF {
+		return nil, fmt.Errorf("unexpected `%s` at position %d", t.value, t.pos)
+	}
+
+	return p.references, nil
+}
+
+func (p *attributeExpressionParser) peek() attributeExpressionToken {
+	return p.tokens[p.pos]
+}
+
+func (p *attributeExpressionParser) next() attributeExpressionToken {
+	t := p.tokens[p.pos]
+	if t.kind != attributeExpressionTokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *attributeExpressionParser) acceptSymbol(symbols ...string) bool {
+	t := p.peek()
+	if t.kind != attributeExpressionTokenSymbol {
+		return false
+	}
+
+	for _, symbol := range symbols {
+		if t.value == symbol {
+			p.pos++
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *attributeExpressionParser) acceptKeyword(keywords ...string) bool {
+	t := p.peek()
+	if t.kind != attributeExpressionTokenIdent {
+		return false
+	}
+
+	for _, keyword := range keywords {
+		if t.value == keyword {
+			p.pos++
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *attributeExpressionParser) expectSymbol(symbol string) error {
+	if !p.acceptSymbol(symbol) {
+		t := p.peek()
+		if t.kind == attributeExpressionTokenEOF {
+			return fmt.Errorf("expected `%s` but the expression ended", symbol)
+		}
+		return fmt.Errorf("expected `%s` but found `%s` at position %d", symbol, t.value, t.pos)
+	}
+
+	return nil
+}
+
+// expression := logical ( ( "?" expression ":" | "?:" ) expression )?
+func (p *attributeExpressionParser) parseExpression() error {
+
+	if err := p.parseBinary(0); err != nil {
+		return err
+	}
+
+	if p.acceptSymbol("?:") {
+		return p.parseExpression()
+	}
+
+	if p.acceptSymbol("?") {
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+
+		if err := p.expectSymbol(":"); err != nil {
+			return err
+		}
+
+		return p.parseExpression()
+	}
+
+	return nil
+}
+
+// Binary operators by increasing precedence
+var attributeExpressionBinaryOperators = [][]string{
+	{"||", "or"},
+	{"&&", "and"},
+	{"==", "!=", "<", ">", "<=", ">=", "eq", "ne", "lt", "gt", "le", "ge", "instanceof", "matches"},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *attributeExpressionParser) parseBinary(level int) error {
+
+	if level == len(attributeExpressionBinaryOperators) {
+		return p.parseUnary()
+	}
+
+	if err := p.parseBinary(level + 1); err != nil {
+		return err
+	}
+
+	for p.acceptSymbol(attributeExpressionBinaryOperators[level]...) || p.acceptKeyword(attributeExpressionBinaryOperators[level]...) {
+		if err := p.parseBinary(level + 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// unary := ( "!" | "-" | "not" ) unary | postfix
+func (p *attributeExpressionParser) parseUnary() error {
+
+	if p.acceptSymbol("!", "-") || p.acceptKeyword("not") {
+		return p.parseUnary()
+	}
+
+	return p.parsePostfix()
+}
+
+// postfix := primary ( ( "." | "?." ) identifier arguments? | "[" expression "]" | selection )*
+// selection := ( ".?[" | ".![" | ".^[" | ".$[" ) expression "]"
+func (p *attributeExpressionParser) parsePostfix() error {
+
+	path, err := p.parsePrimary()
+	if err != nil {
+		return err
+	}
+
+	for {
+		if p.acceptSymbol(".", "?.") {
+			t := p.next()
+			if t.kind != attributeExpressionTokenIdent {
+				return p.unexpected(t, "a property or method name")
+			}
+
+			if p.acceptSymbol("(") {
+				// The path ends at the first method call
+				p.addReference(path)
+				path = ""
+
+				if err := p.parseArguments(); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if path != "" {
+				path = fmt.Sprintf("%s.%s", path, t.value)
+			}
+			continue
+		}
+
+		if p.acceptSymbol(".?[", ".![", ".^[", ".$[") {
+			// Collection selection and projection, e.g. `user.emails.?[primary == true]`.  The path ends at the collection,
+			// and the inner expression is evaluated against each element, so its identifiers aren't attribute references
+			p.addReference(path)
+			path = ""
+
+			references := len(p.references)
+			if err := p.parseExpression(); err != nil {
+				return err
+			}
+			p.references = p.references[:references]
+
+			if err := p.expectSymbol("]"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if p.acceptSymbol("[") {
+			p.addReference(path)
+			path = ""
+
+			if err := p.parseExpression(); err != nil {
+				return err
+			}
+
+			if err := p.expectSymbol("]"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		break
+	}
+
+	p.addReference(path)
+
+	return nil
+}
+
+// Returns the start of an attribute path where the primary is a plain identifier, otherwise an empty string
+func (p *attributeExpressionParser) parsePrimary() (string, error) {
+
+	t := p.next()
+
+	switch t.kind {
+	case attributeExpressionTokenNumber, attributeExpressionTokenString:
+		return "", nil
+
+	case attributeExpressionTokenIdent:
+		if attributeExpressionKeywordOperators[t.value] {
+			return "", p.unexpected(t, "a value")
+		}
+
+		if t.value == "true" || t.value == "false" || t.value == "null" {
+			return "", nil
+		}
+
+		// A function call
+		if p.acceptSymbol("(") {
+			return "", p.parseArguments()
+		}
+
+		return t.value, nil
+
+	case attributeExpressionTokenSymbol:
+		switch t.value {
+		case "#":
+			// Variables and helper functions, e.g. #root, #data.getJsonValue(...), #string.substring(...)
+			v := p.next()
+			if v.kind != attributeExpressionTokenIdent {
+				return "", p.unexpected(v, "a variable name")
+			}
+
+			if p.acceptSymbol("(") {
+				return "", p.parseArguments()
+			}
+
+			return "", nil
+
+		case "(":
+			if err := p.parseExpression(); err != nil {
+				return "", err
+			}
+
+			return "", p.expectSymbol(")")
+
+		case "{":
+			// Inline list
+			if p.acceptSymbol("}") {
+				return "", nil
+			}
+
+			for {
+				if err := p.parseExpression(); err != nil {
+					return "", err
+				}
+
+				if p.acceptSymbol("}") {
+					return "", nil
+				}
+
+				if err := p.expectSymbol(","); err != nil {
+					return "", err
+				}
+			}
+		}
+	}
+
+	return "", p.unexpected(t, "a value")
+}
+
+// arguments := ( expression ( "," expression )* )? ")"
+func (p *attributeExpressionParser) parseArguments() error {
+
+	if p.acceptSymbol(")") {
+		return nil
+	}
+
+	for {
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+
+		if p.acceptSymbol(")") {
+			return nil
+		}
+
+		if err := p.expectSymbol(","); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *attributeExpressionParser) unexpected(t attributeExpressionToken, expected string) error {
+	if t.kind == attributeExpressionTokenEOF {
+		return fmt.Errorf("expected %s but the expression ended", expected)
+	}
+
+	return fmt.Errorf("expected %s but found `%s` at position %d", expected, t.value, t.pos)
+}
+
+func (p *attributeExpressionParser) addReference(path string) {
+	if path != "" {
+		p.references = append(p.references, path)
+	}
+}
+
+// ValidateDiagFunc for attribute mapping values
+func validateAttributeExpression(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	if _, err := parseAttributeExpressionTemplate(value); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid attribute expression",
+			Detail:        fmt.Sprintf("The value \"%s\" is not a valid PingOne attribute expression: %v\n", value, err),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// ValidateDiagFunc for plain attribute paths, such as resource scope schema attributes
+func validateAttributePath(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	if value != "*" && !attributePathRegex.MatchString(value) {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid attribute name",
+			Detail:        fmt.Sprintf("The value \"%s\" is not a valid PingOne attribute name, e.g. `email` or `name.given`\n", value),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+// The online check of attribute references against the environment's User schema, enabled with the provider's
+// `validate_attribute_references` argument.  The top level attribute is checked, and for complex attributes such as
+// `name` the sub-attribute is checked too
+func validateUserAttributeReferences(ctx context.Context, d *schema.ResourceDiff, p1Client *p1Client, references []string) error {
+
+	if !p1Client.validateAttributeReferences || !d.NewValueKnown("environment_id") {
+		return nil
+	}
+
+	envID := d.Get("environment_id").(string)
+
+	attributeNames, err := p1Client.getUserSchemaAttributeNames(ctx, envID)
+	if err != nil {
+		log.Printf("[WARN] Cannot read the User schema attributes for environment %s, skipping the attribute reference check: %v", envID, err)
+		return nil
+	}
+
+	for _, reference := range references {
+		attributePath := strings.Split(reference, ".")
+
+		if attributePath[0] != "user" {
+			continue
+		}
+
+		if len(attributePath) < 2 {
+			continue
+		}
+
+		if !attributeNames[attributePath[1]] {
+			return fmt.Errorf("`%s` references the attribute `%s`, which doesn't exist in the User schema of environment %s", reference, attributePath[1], envID)
+		}
+
+		if len(attributePath) < 3 || !hasSubAttributes(attributeNames, attributePath[1]) {
+			continue
+		}
+
+		if subAttributeName := fmt.Sprintf("%s.%s", attributePath[1], attributePath[2]); !attributeNames[subAttributeName] {
+			return fmt.Errorf("`%s` references the attribute `%s`, which doesn't exist in the User schema of environment %s", reference, subAttributeName, envID)
+		}
+	}
+
+	return nil
+}
+
+func hasSubAttributes(attributeNames map[string]bool, name string) bool {
+	for k := range attributeNames {
+		if strings.HasPrefix(k, name+".") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package pingone
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAttributeExpressionTemplate(t *testing.T) {
+
+	cases := []struct {
+		value      string
+		references []string
+	}{
+		{"", []string{}},
+		{"static value", []string{}},
+		{"${user.id}", []string{"user.id"}},
+		{"${user.name.given + ' ' + user.name.family}", []string{"user.name.given", "user.name.family"}},
+		{"${user.name.family.toUpperCase()}", []string{"user.name.family"}},
+		{"prefix-${user.email}-suffix", []string{"user.email"}},
+		{"${user.email}:${user.username}", []string{"user.email", "user.username"}},
+		{"${user.nickname ?: user.name.given}", []string{"user.nickname", "user.name.given"}},
+		{"${user.enabled ? 'yes' : 'no'}", []string{"user.enabled"}},
+		{"${user?.address?.locality}", []string{"user.address.locality"}},
+		{"${user.memberOfGroupNames[0]}", []string{"user.memberOfGroupNames"}},
+		{"${user.mobilePhone != null && user.mobilePhone matches '[0-9]+'}", []string{"user.mobilePhone", "user.mobilePhone"}},
+		{"${not user.enabled or user.accountId eq 'x'}", []string{"user.enabled", "user.accountId"}},
+		{"${#data.getJsonValue(user.population, 'id')}", []string{"user.population"}},
+		{"${#root.user.id}", []string{}},
+		{"${{'a', 'b'}}", []string{}},
+		{"${'it''s'}", []string{}},
+		{"${1.5 * 2}", []string{}},
+		{"${user.emails.?[primary == true]}", []string{"user.emails"}},
+		{"${user.emails.![value]}", []string{"user.emails"}},
+		{"${user.emails.^[type == 'work'].value}", []string{"user.emails"}},
+		{"${user.emails.$[type == 'home']}", []string{"user.emails"}},
+		{"${user.emails.?[#this.type == type].size()}", []string{"user.emails"}},
+	}
+
+	for _, c := range cases {
+		references, err := parseAttributeExpressionTemplate(c.value)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", c.value, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(references, c.references) {
+			t.Errorf("%q: expected references %v, got %v", c.value, c.references, references)
+		}
+	}
+}
+
+func TestParseAttributeExpressionTemplateInvalid(t *testing.T) {
+
+	cases := []string{
+		"${}",
+		"${ }",
+		"${user.id",
+		"${'unterminated}",
+		"${user.}",
+		"${user..id}",
+		"${user.id +}",
+		"${(user.id}",
+		"${user.id)}",
+		"${user.emails[0}",
+		"${user.emails.?[primary}",
+		"${user.id user.email}",
+		"${user.id ? 'a'}",
+		"${and}",
+		"${#}",
+		"${user.id @ 1}",
+	}
+
+	for _, value := range cases {
+		if _, err := parseAttributeExpressionTemplate(value); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
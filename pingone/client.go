@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/patrickcping/pingone-go"
 	"golang.org/x/oauth2"
@@ -15,31 +16,39 @@ type p1ClientConfig struct {
 	ClientSecret  string
 	EnvironmentID string
 	Region        string
+	// Checks attribute mapping references against the environment's User schema at plan time
+	ValidateAttributeReferences bool
 }
 
 type p1Client struct {
-	APIClient    *pingone.APIClient
-	regionSuffix string
+	APIClient           *pingone.APIClient
+	environmentID       string
+	organizationID      string
+	organizationIDMutex sync.Mutex
+	region              p1Region
+	regionSuffix        string
+	roles               []pingone.Role
+	rolesMutex          sync.Mutex
+
+	validateAttributeReferences bool
+	userSchemaAttributeNames    map[string]map[string]bool
+	schemaAttributesMutex       sync.Mutex
+
+	schemaAttributeMutexes     map[string]*sync.Mutex
+	schemaAttributeMutexesLock sync.Mutex
 }
 
 func (c *p1ClientConfig) ApiClient(ctx context.Context) (*p1Client, error) {
 	// var err error
 	var client *pingone.APIClient
 
-	var regionSuffix string
-	switch p1Region := c.Region; p1Region {
-	case "EU":
-		regionSuffix = "eu"
-	case "US":
-		regionSuffix = "com"
-	case "ASIA":
-		regionSuffix = "asia"
-	case "CA":
-		regionSuffix = "ca"
-	default:
-		regionSuffix = "com"
+	region, err := findRegion(c.Region)
+	if err != nil {
+		return nil, err
 	}
 
+	regionSuffix := region.URLSuffix
+
 	token, err := getToken(ctx, c, regionSuffix)
 	if err != nil {
 		return nil, err
@@ -52,14 +61,158 @@ func (c *p1ClientConfig) ApiClient(ctx context.Context) (*p1Client, error) {
 	log.Printf("[INFO] PingOne Client using region suffix %s", regionSuffix)
 
 	apiClient := &p1Client{
-		APIClient:    client,
-		regionSuffix: regionSuffix,
+		APIClient:     client,
+		environmentID: c.EnvironmentID,
+		region:        region,
+		regionSuffix:  regionSuffix,
+
+		validateAttributeReferences: c.ValidateAttributeReferences,
 	}
 
 	log.Printf("[INFO] PingOne Client configured")
 	return apiClient, nil
 }
 
+// The organization isn't part of the provider configuration, so it is looked up from the worker app's environment and cached
+func (c *p1Client) getOrganizationID(ctx context.Context) (string, error) {
+	c.organizationIDMutex.Lock()
+	defer c.organizationIDMutex.Unlock()
+
+	if c.organizationID != "" {
+		return c.organizationID, nil
+	}
+
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": c.regionSuffix,
+	})
+
+	resp, r, err := c.APIClient.ManagementAPIsEnvironmentsApi.ReadOneEnvironment(ctx, c.environmentID).Execute()
+	if err != nil {
+		if r != nil {
+			return "", fmt.Errorf("error when calling `ManagementAPIsEnvironmentsApi.ReadOneEnvironment`: %v\nFull HTTP response: %v", err, r.Body)
+		}
+		return "", fmt.Errorf("error when calling `ManagementAPIsEnvironmentsApi.ReadOneEnvironment`: %v", err)
+	}
+
+	organization := resp.GetOrganization()
+	if organization.Id == nil {
+		return "", fmt.Errorf("no organization returned for environment %s", c.environmentID)
+	}
+
+	c.organizationID = *organization.Id
+
+	return c.organizationID, nil
+}
+
+// Roles are defined by the platform and don't change during a run, so they are read once and shared between the role
+// data sources and the role assignment plan checks
+func (c *p1Client) getRoles(ctx context.Context) ([]pingone.Role, error) {
+	c.rolesMutex.Lock()
+	defer c.rolesMutex.Unlock()
+
+	if c.roles != nil {
+		return c.roles, nil
+	}
+
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": c.regionSuffix,
+	})
+
+	respList, r, err := c.APIClient.ManagementAPIsRolesApi.ReadAllRoles(ctx).Execute()
+	if err != nil {
+		if r != nil {
+			return nil, fmt.Errorf("error when calling `ManagementAPIsRolesApi.ReadAllRoles`: %v\nFull HTTP response: %v", err, r.Body)
+		}
+		return nil, fmt.Errorf("error when calling `ManagementAPIsRolesApi.ReadAllRoles`: %v", err)
+	}
+
+	c.roles = respList.Embedded.GetRoles()
+
+	return c.roles, nil
+}
+
+// Writes to a schema attribute that replace its whole `subAttributes` list are serialised per attribute
+func (c *p1Client) schemaAttributeMutex(attributeID string) *sync.Mutex {
+	c.schemaAttributeMutexesLock.Lock()
+	defer c.schemaAttributeMutexesLock.Unlock()
+
+	if c.schemaAttributeMutexes == nil {
+		c.schemaAttributeMutexes = make(map[string]*sync.Mutex)
+	}
+
+	if _, ok := c.schemaAttributeMutexes[attributeID]; !ok {
+		c.schemaAttributeMutexes[attributeID] = &sync.Mutex{}
+	}
+
+	return c.schemaAttributeMutexes[attributeID]
+}
+
+// The User schema attributes are read once per environment and shared between the attribute mapping plan checks
+func (c *p1Client) getUserSchemaAttributeNames(ctx context.Context, envID string) (map[string]bool, error) {
+	c.schemaAttributesMutex.Lock()
+	defer c.schemaAttributesMutex.Unlock()
+
+	if v, ok := c.userSchemaAttributeNames[envID]; ok {
+		return v, nil
+	}
+
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": c.regionSuffix,
+	})
+
+	respList, r, err := c.APIClient.ManagementAPIsSchemasApi.ReadAllSchemas(ctx, envID).Execute()
+	if err != nil {
+		if r != nil {
+			return nil, fmt.Errorf("error when calling `ManagementAPIsSchemasApi.ReadAllSchemas`: %v\nFull HTTP response: %v", err, r.Body)
+		}
+		return nil, fmt.Errorf("error when calling `ManagementAPIsSchemasApi.ReadAllSchemas`: %v", err)
+	}
+
+	var schemaID string
+	for _, v := range respList.Embedded.GetSchemas() {
+		if v.GetName() == "User" {
+			schemaID = v.GetId()
+			break
+		}
+	}
+
+	if schemaID == "" {
+		return nil, fmt.Errorf("no User schema found in environment %s", envID)
+	}
+
+	attributes, diags := readAllSchemaAttributeItems(ctx, c.APIClient, envID, schemaID)
+	if diags.HasError() {
+		return nil, fmt.Errorf("%s: %s", diags[0].Summary, diags[0].Detail)
+	}
+
+	// Sub-attributes of complex attributes are included with dotted names, e.g. `name.given`
+	attributeNames := make(map[string]bool)
+	for _, v := range attributes {
+		attribute, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, _ := attribute["name"].(string)
+		attributeNames[name] = true
+
+		if subAttributes, ok := attribute["subAttributes"].([]interface{}); ok {
+			for _, v1 := range subAttributes {
+				if subAttribute, ok := v1.(map[string]interface{}); ok {
+					attributeNames[fmt.Sprintf("%s.%s", name, subAttribute["name"])] = true
+				}
+			}
+		}
+	}
+
+	if c.userSchemaAttributeNames == nil {
+		c.userSchemaAttributeNames = make(map[string]map[string]bool)
+	}
+	c.userSchemaAttributeNames[envID] = attributeNames
+
+	return attributeNames, nil
+}
+
 func getToken(ctx context.Context, c *p1ClientConfig, regionSuffix string) (*oauth2.Token, error) {
 
 	//Get URL from SDK
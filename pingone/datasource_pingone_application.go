@@ -0,0 +1,347 @@
+package pingone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceApplication() *schema.Resource {
+
+	applicationSchema := applicationComputedSchema()
+
+	applicationSchema["environment_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	applicationSchema["application_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"application_id", "name"},
+	}
+	applicationSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"application_id", "name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: datasourceApplicationRead,
+
+		Schema: applicationSchema,
+	}
+}
+
+// Attributes returned for each application, shared between the pingone_application and pingone_applications data sources.
+// Protocol specific attributes are left empty where they don't apply to the application
+func applicationComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"application_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"protocol": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"type": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"tags": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"home_page_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"login_page_url": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"icon": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"href": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"access_control": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"role_type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"group": {
+						Type:     schema.TypeList,
+						Computed: true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"type": {
+									Type:     schema.TypeString,
+									Computed: true,
+								},
+								"groups": {
+									Type: schema.TypeList,
+									Elem: &schema.Schema{
+										Type: schema.TypeString,
+									},
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"grant_types": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"response_types": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"token_endpoint_authn_method": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"pkce_enforcement": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"redirect_uris": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"post_logout_redirect_uris": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"acs_urls": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"sp_entity_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"assertion_duration": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"name_id_format": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"slo_endpoint": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func datasourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	appID := d.Get("application_id").(string)
+	appName := d.Get("name").(string)
+
+	var resp map[string]interface{}
+	if appName != "" {
+
+		// The applications endpoint doesn't support filtering, so the name is matched against every application in the environment
+		applications, listDiags := readAllApplications(ctx, api_client, envID)
+		diags = append(diags, listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for _, application := range applications {
+			if application["name"] == appName {
+				resp = application
+				break
+			}
+		}
+
+		if resp == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot find application with name %s", appName),
+			})
+
+			return diags
+		}
+
+	} else {
+
+		respOne, r, err := api_client.ManagementAPIsApplicationsApplicationsApi.ReadOneApplication(ctx, envID, appID).Execute()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationsApi.ReadOneApplication``: %v", err),
+				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+			})
+
+			return diags
+		}
+
+		application, err := flattenApplication(respOne)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cannot flatten Application from SDK object",
+				Detail:   fmt.Sprintf("Full error: %v\n", err),
+			})
+
+			return diags
+		}
+
+		resp = application
+	}
+
+	log.Printf("Application found %s", resp["name"])
+
+	d.SetId(resp["application_id"].(string))
+	for k, v := range resp {
+		d.Set(k, v)
+	}
+
+	return diags
+}
+
+// The SDK returns applications as untyped objects, so they are re-read into the protocol's model before being flattened.
+// External link applications have no model of their own; their attributes are a subset of the OIDC model
+func flattenApplication(in interface{}) (map[string]interface{}, error) {
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+
+	application := pingone.ApplicationOIDC{}
+	if err := json.Unmarshal(b, &application); err != nil {
+		return nil, err
+	}
+
+	item := map[string]interface{}{
+		"application_id":              application.GetId(),
+		"name":                        application.GetName(),
+		"description":                 application.GetDescription(),
+		"enabled":                     application.GetEnabled(),
+		"protocol":                    application.GetProtocol(),
+		"type":                        application.GetType(),
+		"tags":                        application.GetTags(),
+		"home_page_url":               application.GetHomePageUrl(),
+		"login_page_url":              application.GetLoginPageUrl(),
+		"icon":                        make([]interface{}, 0),
+		"access_control":              make([]interface{}, 0),
+		"grant_types":                 make([]string, 0),
+		"response_types":              make([]string, 0),
+		"token_endpoint_authn_method": "",
+		"pkce_enforcement":            "",
+		"redirect_uris":               make([]string, 0),
+		"post_logout_redirect_uris":   make([]string, 0),
+		"acs_urls":                    make([]string, 0),
+		"sp_entity_id":                "",
+		"assertion_duration":          0,
+		"name_id_format":              "",
+		"slo_endpoint":                "",
+	}
+
+	if v, ok := application.GetIconOk(); ok {
+		iconFlattened, err := flattenApplicationIcon(v)
+		if err != nil {
+			return nil, err
+		}
+		item["icon"] = iconFlattened
+	}
+
+	if v, ok := application.GetAccessControlOk(); ok {
+		accessControlFlattened, err := flattenApplicationAccessControl(v)
+		if err != nil {
+			return nil, err
+		}
+		item["access_control"] = accessControlFlattened
+	}
+
+	switch application.GetProtocol() {
+	case "OPENID_CONNECT":
+		item["grant_types"] = application.GetGrantTypes()
+		item["response_types"] = application.GetResponseTypes()
+		item["token_endpoint_authn_method"] = application.GetTokenEndpointAuthMethod()
+		item["pkce_enforcement"] = application.GetPkceEnforcement()
+		item["redirect_uris"] = application.GetRedirectUris()
+		item["post_logout_redirect_uris"] = application.GetPostLogoutRedirectUris()
+
+	case "SAML":
+		applicationSAML := pingone.ApplicationSAML{}
+		if err := json.Unmarshal(b, &applicationSAML); err != nil {
+			return nil, err
+		}
+
+		item["acs_urls"] = applicationSAML.GetAcsUrls()
+		item["sp_entity_id"] = applicationSAML.GetSpEntityId()
+		item["assertion_duration"] = applicationSAML.GetAssertionDuration()
+		item["name_id_format"] = applicationSAML.GetNameIdFormat()
+		item["slo_endpoint"] = applicationSAML.GetSloEndpoint()
+	}
+
+	return item, nil
+}
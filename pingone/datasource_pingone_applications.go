@@ -0,0 +1,163 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceApplications() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceApplicationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OPENID_CONNECT", "SAML", "EXTERNAL_LINK"}, false),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"applications": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: applicationComputedSchema(),
+				},
+			},
+		},
+	}
+}
+
+func datasourceApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	log.Printf("[INFO] Reading PingOne Applications: environment %s", envID)
+
+	resp, listDiags := readAllApplications(ctx, api_client, envID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The applications endpoint doesn't support filtering, so the filters are applied to the full list here
+	protocol, filterProtocol := d.GetOk("protocol")
+	appType, filterType := d.GetOk("type")
+	namePrefix, filterNamePrefix := d.GetOk("name_prefix")
+	// GetOkExists is needed so that an explicit `enabled = false` is still applied as a filter
+	enabled, filterEnabled := d.GetOkExists("enabled")
+
+	ids := make([]string, 0)
+	applications := make([]interface{}, 0)
+
+	for _, application := range resp {
+
+		if filterProtocol && application["protocol"] != protocol.(string) {
+			continue
+		}
+
+		if filterType && application["type"] != appType.(string) {
+			continue
+		}
+
+		if filterNamePrefix && !strings.HasPrefix(application["name"].(string), namePrefix.(string)) {
+			continue
+		}
+
+		if filterEnabled && application["enabled"] != enabled.(bool) {
+			continue
+		}
+
+		ids = append(ids, application["application_id"].(string))
+		applications = append(applications, application)
+	}
+
+	log.Printf("Applications found: %d", len(ids))
+
+	d.SetId(fmt.Sprintf("%s/%d", envID, schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("applications", applications)
+
+	return diags
+}
+
+// Reads every page of applications in the environment, flattened for use in the application data sources
+func readAllApplications(ctx context.Context, api_client *pingone.APIClient, envID string) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	_, r, err := api_client.ManagementAPIsApplicationsApplicationsApi.ReadAllApplications(ctx, envID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationsApi.ReadAllApplications``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return nil, diags
+	}
+
+	applications := make([]map[string]interface{}, 0)
+
+	items, err := readAllPages(ctx, api_client, r, "applications")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the applications json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	for _, v := range items {
+
+		application, err := flattenApplication(v)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cannot flatten Application from SDK object",
+				Detail:   fmt.Sprintf("Full error: %v\n", err),
+			})
+
+			return nil, diags
+		}
+
+		applications = append(applications, application)
+	}
+
+	return applications, diags
+}
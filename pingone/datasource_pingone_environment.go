@@ -93,7 +93,7 @@ func datasourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("name", resp.GetName())
 	d.Set("description", resp.GetDescription())
 	d.Set("type", resp.GetType())
-	d.Set("region", resp.GetRegion())
+	d.Set("region", flattenRegion(resp.GetRegion()))
 	d.Set("license_id", resp.GetLicense().Id)
 
 	return diags
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/patrickcping/pingone-go"
 )
 
@@ -19,6 +21,24 @@ func datasourceEnvironments() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PRODUCTION", "SANDBOX"}, false),
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(regionCodes(), false),
+			},
+			"license_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"environments": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -48,6 +68,17 @@ func datasourceEnvironments() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"default_population_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"products": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
 					},
 				},
 			},
@@ -63,12 +94,19 @@ func datasourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, met
 	})
 	var diags diag.Diagnostics
 
-	filter := d.Get("filter").(string)
+	filter := buildEnvironmentsFilter(d)
+
+	log.Printf("[INFO] Reading PingOne Environments: filter %s", filter)
 
 	var resp []pingone.Environment
 
 	limit := int32(1000)
-	respList, r, err := api_client.ManagementAPIsEnvironmentsApi.ReadAllEnvironments(ctx).Limit(limit).Filter(filter).Execute()
+	request := api_client.ManagementAPIsEnvironmentsApi.ReadAllEnvironments(ctx).Limit(limit)
+	if filter != "" {
+		request = request.Filter(filter)
+	}
+
+	_, r, err := request.Execute()
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -79,23 +117,137 @@ func datasourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
-	resp = respList.Embedded.GetEnvironments()
-	log.Printf("Environments found")
+	items, err := readAllPages(ctx, api_client, r, "environments")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the environments json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	if err := decodeItems(items, &resp); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal environments json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
 
+		return diags
+	}
+
+	log.Printf("Environments found: %d", len(resp))
+
+	ids := make([]string, 0, len(resp))
 	environments := make([]interface{}, 0, len(resp))
 	for _, environment := range resp {
 
+		defaultPopulationID, popDiags := readEnvironmentDefaultPopulationID(ctx, api_client, environment.GetId())
+		diags = append(diags, popDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		billOfMaterials := environment.GetBillOfMaterials()
+		if environment.BillOfMaterials == nil {
+			respBOM, rBOM, errBOM := api_client.ManagementAPIsBillOfMaterialsBOMApi.ReadOneBillOfMaterials(ctx, environment.GetId()).Execute()
+			if errBOM != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("Error when calling `ManagementAPIsBillOfMaterialsBOMApi.ReadOneBillOfMaterials``: %v", errBOM),
+					Detail:   fmt.Sprintf("Full HTTP response: %v\n", rBOM.Body),
+				})
+
+				return diags
+			}
+
+			billOfMaterials = respBOM
+		}
+
+		products := make([]string, 0)
+		for _, product := range billOfMaterials.GetProducts() {
+			products = append(products, product.GetType())
+		}
+
+		ids = append(ids, environment.GetId())
 		environments = append(environments, map[string]interface{}{
-			"environment_id": environment.GetId(),
-			"name":           environment.GetName(),
-			"description":    environment.GetDescription(),
-			"type":           environment.GetType(),
-			"region":         environment.GetRegion(),
-			"license_id":     environment.License.GetId(),
+			"environment_id":        environment.GetId(),
+			"name":                  environment.GetName(),
+			"description":           environment.GetDescription(),
+			"type":                  environment.GetType(),
+			"region":                flattenRegion(environment.GetRegion()),
+			"license_id":            environment.License.GetId(),
+			"default_population_id": defaultPopulationID,
+			"products":              products,
 		})
 	}
 
+	d.SetId(fmt.Sprintf("%s/%d", filter, schema.HashString(strings.Join(ids, ","))))
 	d.Set("environments", environments)
 
 	return diags
 }
+
+// Compiles the typed filter arguments into a SCIM filter, combined with any raw filter that has been given
+func buildEnvironmentsFilter(d *schema.ResourceData) string {
+	filters := make([]string, 0)
+
+	if v, ok := d.GetOk("filter"); ok {
+		filters = append(filters, fmt.Sprintf("(%s)", v.(string)))
+	}
+
+	if v, ok := d.GetOk("name_prefix"); ok {
+		filters = append(filters, fmt.Sprintf("name sw %s", scimFilterValue(v.(string))))
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		filters = append(filters, fmt.Sprintf("type eq %s", scimFilterValue(v.(string))))
+	}
+
+	if v, ok := d.GetOk("region"); ok {
+		filters = append(filters, fmt.Sprintf("region eq %s", scimFilterValue(flattenRegion(v.(string)))))
+	}
+
+	if v, ok := d.GetOk("license_id"); ok {
+		filters = append(filters, fmt.Sprintf("license.id eq %s", scimFilterValue(v.(string))))
+	}
+
+	return strings.Join(filters, " and ")
+}
+
+// The SDK population model doesn't include the `default` flag, so it is read from the raw populations response
+func readEnvironmentDefaultPopulationID(ctx context.Context, api_client *pingone.APIClient, envID string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	_, r, err := api_client.ManagementAPIsPopulationsApi.ReadAllPopulations(ctx, envID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.ReadAllPopulations``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return "", diags
+	}
+
+	items, err := readAllPages(ctx, api_client, r, "populations")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the populations json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return "", diags
+	}
+
+	for id, isDefault := range populationDefaults(items) {
+		if isDefault {
+			return id, diags
+		}
+	}
+
+	return "", diags
+}
@@ -0,0 +1,98 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceGatewayCredentials() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceGatewayCredentialsRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"credentials": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"credential_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_used_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceGatewayCredentialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	gatewayID := d.Get("gateway_id").(string)
+
+	resp, r, err := readGatewayCredentials(ctx, api_client, envID, gatewayID)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsGatewayManagementGatewaysApi.ReadOneGateway``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	log.Printf("Gateway credentials found: %d", len(resp))
+
+	ids := make([]string, 0, len(resp))
+	credentials := make([]interface{}, 0, len(resp))
+	for _, credential := range resp {
+		ids = append(ids, credential.GetId())
+		credentials = append(credentials, map[string]interface{}{
+			"credential_id": credential.GetId(),
+			"created_at":    credential.GetCreatedAt(),
+			"last_used_at":  credential.GetLastUsedAt(),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", envID, gatewayID, schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("credentials", credentials)
+
+	return diags
+}
@@ -0,0 +1,151 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceGatewayInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceGatewayInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"gateway_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hostname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"connected": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"health_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"update_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"credential_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"initialized_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_reported_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"current_errors": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceGatewayInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	gatewayID := d.Get("gateway_id").(string)
+
+	resp, listDiags := readGatewayInstances(ctx, api_client, envID, gatewayID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	log.Printf("Gateway instances found: %d", len(resp))
+
+	ids := make([]string, 0, len(resp))
+	instances := make([]interface{}, 0, len(resp))
+	for _, instance := range resp {
+
+		version := instance.GetVersion()
+
+		ids = append(ids, instance.GetId())
+		instances = append(instances, map[string]interface{}{
+			"instance_id":      instance.GetId(),
+			"hostname":         instance.GetHostname(),
+			"connected":        instance.GetConnected(),
+			"health_status":    instance.GetHealthStatus(),
+			"version":          version.GetVersionNumber(),
+			"update_status":    version.GetUpdateStatus(),
+			"credential_id":    instance.Credential.GetId(),
+			"initialized_at":   instance.GetInitializedAt(),
+			"last_reported_at": instance.GetLastReportedAt(),
+			"current_errors":   instance.GetCurrentErrors(),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", envID, gatewayID, schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("instances", instances)
+
+	return diags
+}
+
+func readGatewayInstances(ctx context.Context, api_client *pingone.APIClient, envID, gatewayID string) ([]pingone.GatewayInstance, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	respList, r, err := api_client.ManagementAPIsGatewayManagementGatewayInstancesApi.ReadAllGatewayInstances(ctx, envID, gatewayID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsGatewayManagementGatewayInstancesApi.ReadAllGatewayInstances``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return nil, diags
+	}
+
+	return respList.Embedded.GetGatewayInstances(), diags
+}
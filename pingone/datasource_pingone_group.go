@@ -62,7 +62,7 @@ func datasourceGroupRead(ctx context.Context, d *schema.ResourceData, meta inter
 	var resp pingone.Group
 	if groupName != "" {
 
-		filter := fmt.Sprintf("name eq \"%s\"", groupName)
+		filter := fmt.Sprintf("name eq %s", scimFilterValue(groupName))
 		limit := int32(1)
 
 		respList, r, err := api_client.ManagementAPIsGroupsApi.ReadAllGroups(ctx, envID).Filter(filter).Limit(limit).Execute()
@@ -76,7 +76,17 @@ func datasourceGroupRead(ctx context.Context, d *schema.ResourceData, meta inter
 			return diags
 		}
 
-		resp = respList.Embedded.GetGroups()[0]
+		groups := respList.Embedded.GetGroups()
+		if len(groups) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot find group with name %s", groupName),
+			})
+
+			return diags
+		}
+
+		resp = groups[0]
 
 	} else {
 
@@ -0,0 +1,342 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceLicense() *schema.Resource {
+
+	licenseSchema := licenseComputedSchema()
+
+	licenseSchema["license_id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"name", "package"},
+	}
+	licenseSchema["name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+	licenseSchema["package"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+	}
+
+	return &schema.Resource{
+		ReadContext: datasourceLicenseRead,
+
+		Schema: licenseSchema,
+	}
+}
+
+// Attributes returned for each license, shared between the pingone_license and pingone_licenses data sources
+func licenseComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"license_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"package": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"begins_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"expires_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"terminates_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"replaces_license_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"replaced_by_license_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"assigned_environments_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"max_environments": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"max_users": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"allow_production": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"regions": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"products": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+}
+
+func datasourceLicenseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	var diags diag.Diagnostics
+
+	orgID, err := p1Client.getOrganizationID(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot determine the organization of the provider environment",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	var license map[string]interface{}
+
+	if licenseID, ok := d.GetOk("license_id"); ok {
+
+		resp, licenseDiags := readLicense(ctx, p1Client, orgID, licenseID.(string))
+		diags = append(diags, licenseDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		license = resp
+
+	} else {
+
+		licenses, licenseDiags := readAllLicenses(ctx, p1Client, orgID)
+		diags = append(diags, licenseDiags...)
+		if diags.HasError() {
+			return diags
+		}
+
+		name := d.Get("name").(string)
+		licensePackage := d.Get("package").(string)
+
+		matches := make([]map[string]interface{}, 0)
+		for _, v := range licenses {
+			if (name == "" || v["name"] == name) && (licensePackage == "" || v["package"] == licensePackage) {
+				matches = append(matches, v)
+			}
+		}
+
+		// Renewed licenses share a name and package with the license they replace, so prefer the active one
+		if len(matches) > 1 {
+			activeMatches := make([]map[string]interface{}, 0)
+			for _, v := range matches {
+				if v["status"] == "ACTIVE" {
+					activeMatches = append(activeMatches, v)
+				}
+			}
+			matches = activeMatches
+		}
+
+		if len(matches) != 1 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Expected exactly one license with name \"%s\" and package \"%s\", found %d", name, licensePackage, len(matches)),
+			})
+
+			return diags
+		}
+
+		license = matches[0]
+	}
+
+	log.Printf("License found %s", license["name"])
+
+	flattenedLicense := flattenLicense(license)
+
+	d.SetId(flattenedLicense["license_id"].(string))
+	for k, v := range flattenedLicense {
+		d.Set(k, v)
+	}
+
+	return diags
+}
+
+func readLicense(ctx context.Context, p1Client *p1Client, orgID, licenseID string) (map[string]interface{}, diag.Diagnostics) {
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	r, err := api_client.ManagementAPIsLicensesApi.V1OrganizationsOrgIDLicensesLicenseIDGet(ctx, orgID, licenseID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLicensesApi.V1OrganizationsOrgIDLicensesLicenseIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return nil, diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal license json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	return resp, diags
+}
+
+func readAllLicenses(ctx context.Context, p1Client *p1Client, orgID string) ([]map[string]interface{}, diag.Diagnostics) {
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	r, err := api_client.ManagementAPIsLicensesApi.V1OrganizationsOrgIDLicensesGet(ctx, orgID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLicensesApi.V1OrganizationsOrgIDLicensesGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return nil, diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal licenses json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	licenses := make([]map[string]interface{}, 0)
+
+	if embedded, ok := resp["_embedded"].(map[string]interface{}); ok {
+		if items, ok := embedded["licenses"].([]interface{}); ok {
+			for _, v := range items {
+				licenses = append(licenses, v.(map[string]interface{}))
+			}
+		}
+	}
+
+	return licenses, diags
+}
+
+// Licenses don't list their products, each licensed PingOne service has its own section in the license instead
+var licenseProductSections = map[string]string{
+	"mfa":          "PING_ONE_MFA",
+	"intelligence": "PING_ONE_RISK",
+	"verify":       "PING_ONE_VERIFY",
+}
+
+func licenseProducts(license map[string]interface{}) []string {
+	products := []string{"PING_ONE_BASE"}
+
+	for section, product := range licenseProductSections {
+		if _, ok := license[section].(map[string]interface{}); ok {
+			products = append(products, product)
+		}
+	}
+
+	sort.Strings(products)
+
+	return products
+}
+
+func flattenLicense(license map[string]interface{}) map[string]interface{} {
+
+	item := map[string]interface{}{
+		"license_id":                  license["id"],
+		"name":                        license["name"],
+		"package":                     license["package"],
+		"status":                      license["status"],
+		"begins_at":                   license["beginsAt"],
+		"expires_at":                  license["expiresAt"],
+		"terminates_at":               license["terminatesAt"],
+		"replaces_license_id":         "",
+		"replaced_by_license_id":      "",
+		"assigned_environments_count": 0,
+		"max_environments":            0,
+		"max_users":                   0,
+		"allow_production":            false,
+		"regions":                     []interface{}{},
+		"products":                    licenseProducts(license),
+	}
+
+	if v, ok := license["replacesLicense"].(map[string]interface{}); ok {
+		item["replaces_license_id"] = v["id"]
+	}
+
+	if v, ok := license["replacedByLicense"].(map[string]interface{}); ok {
+		item["replaced_by_license_id"] = v["id"]
+	}
+
+	if v, ok := license["assignedEnvironmentsCount"].(float64); ok {
+		item["assigned_environments_count"] = int(v)
+	}
+
+	if environments, ok := license["environments"].(map[string]interface{}); ok {
+		if v, ok := environments["max"].(float64); ok {
+			item["max_environments"] = int(v)
+		}
+
+		if v, ok := environments["allowProduction"].(bool); ok {
+			item["allow_production"] = v
+		}
+
+		if v, ok := environments["regions"].([]interface{}); ok {
+			item["regions"] = v
+		}
+	}
+
+	if users, ok := license["users"].(map[string]interface{}); ok {
+		if v, ok := users["max"].(float64); ok {
+			item["max_users"] = int(v)
+		}
+	}
+
+	return item
+}
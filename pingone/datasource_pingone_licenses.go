@@ -0,0 +1,83 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceLicenses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceLicensesRead,
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVE", "EXPIRED", "FUTURE", "TERMINATED"}, false),
+			},
+			"package": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"licenses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: licenseComputedSchema(),
+				},
+			},
+		},
+	}
+}
+
+func datasourceLicensesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	var diags diag.Diagnostics
+
+	orgID, err := p1Client.getOrganizationID(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot determine the organization of the provider environment",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	licenses, licenseDiags := readAllLicenses(ctx, p1Client, orgID)
+	diags = append(diags, licenseDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	status := d.Get("status").(string)
+	licensePackage := d.Get("package").(string)
+
+	ids := make([]interface{}, 0)
+	items := make([]interface{}, 0)
+
+	for _, license := range licenses {
+		if (status == "" || license["status"] == status) && (licensePackage == "" || license["package"] == licensePackage) {
+			ids = append(ids, license["id"])
+			items = append(items, flattenLicense(license))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", orgID, status, licensePackage))
+	d.Set("ids", ids)
+	d.Set("licenses", items)
+
+	return diags
+}
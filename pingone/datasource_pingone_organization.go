@@ -0,0 +1,153 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceOrganizationRead,
+
+		Schema: map[string]*schema.Schema{
+			"organization_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"billing_connection_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"environment_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func datasourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	var diags diag.Diagnostics
+
+	orgID, err := p1Client.getOrganizationID(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot determine the organization of the provider environment",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+
+	r, err := api_client.ManagementAPIsOrganizationsApi.V1OrganizationsOrgIDGet(ctx, orgID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsOrganizationsApi.V1OrganizationsOrgIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal organization json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	log.Printf("Organization found %s", resp["name"])
+
+	billingConnectionIDs := make([]interface{}, 0)
+	if billingConnections, ok := resp["billingConnections"].([]interface{}); ok {
+		for _, v := range billingConnections {
+			billingConnectionIDs = append(billingConnectionIDs, v.(map[string]interface{})["id"])
+		}
+	}
+
+	limit := int32(1000)
+	_, rList, err := api_client.ManagementAPIsEnvironmentsApi.ReadAllEnvironments(ctx).Limit(limit).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsEnvironmentsApi.ReadAllEnvironments``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", rList.Body),
+		})
+
+		return diags
+	}
+
+	items, err := readAllPages(ctx, api_client, rList, "environments")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the environments json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	environmentIDs := make([]interface{}, 0)
+	environments := make([]pingone.Environment, 0)
+	if err := decodeItems(items, &environments); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal environments json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	for _, environment := range environments {
+		if organization := environment.GetOrganization(); organization.Id == nil || *organization.Id == orgID {
+			environmentIDs = append(environmentIDs, environment.GetId())
+		}
+	}
+
+	d.SetId(orgID)
+	d.Set("organization_id", orgID)
+	d.Set("name", resp["name"])
+	d.Set("description", resp["description"])
+	d.Set("type", resp["type"])
+	d.Set("billing_connection_ids", billingConnectionIDs)
+	d.Set("environment_ids", environmentIDs)
+
+	return diags
+}
@@ -0,0 +1,180 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourcePopulation() *schema.Resource {
+
+	populationSchema := populationComputedSchema()
+
+	populationSchema["environment_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	populationSchema["population_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"population_id", "name"},
+	}
+	populationSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"population_id", "name"},
+	}
+
+	return &schema.Resource{
+		ReadContext: datasourcePopulationRead,
+
+		Schema: populationSchema,
+	}
+}
+
+// Attributes returned for each population, shared between the pingone_population and pingone_populations data sources
+func populationComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"population_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"user_count": {
+			Type:     schema.TypeInt,
+			Computed: true,
+		},
+		"default": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func datasourcePopulationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	popID := d.Get("population_id").(string)
+	popName := d.Get("name").(string)
+
+	var resp pingone.Population
+	var r *http.Response
+	if popName != "" {
+
+		filter := fmt.Sprintf("name eq %s", scimFilterValue(popName))
+		limit := int32(1)
+
+		respList, rList, err := api_client.ManagementAPIsPopulationsApi.ReadAllPopulations(ctx, envID).Filter(filter).Limit(limit).Execute()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.ReadAllPopulations``: %v", err),
+				Detail:   fmt.Sprintf("Full HTTP response: %v\n", rList.Body),
+			})
+
+			return diags
+		}
+
+		populations := respList.Embedded.GetPopulations()
+		if len(populations) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot find population with name %s", popName),
+			})
+
+			return diags
+		}
+
+		resp = populations[0]
+		r = rList
+
+	} else {
+
+		respOne, rOne, err := api_client.ManagementAPIsPopulationsApi.ReadOnePopulation(ctx, envID, popID).Execute()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.ReadOnePopulation``: %v", err),
+				Detail:   fmt.Sprintf("Full HTTP response: %v\n", rOne.Body),
+			})
+
+			return diags
+		}
+
+		resp = respOne
+		r = rOne
+	}
+
+	log.Printf("Population found %s", resp.GetName())
+
+	body, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal population json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp.GetId())
+	for k, v := range flattenPopulation(resp, populationDefaults(append(embeddedItems(body, "populations"), body))) {
+		d.Set(k, v)
+	}
+
+	return diags
+}
+
+// The SDK population model doesn't include the `default` flag, so it is read from the raw population objects, keyed
+// by population ID
+func populationDefaults(populations []interface{}) map[string]bool {
+	defaults := make(map[string]bool)
+
+	for _, v := range populations {
+		population, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		id, ok := population["id"].(string)
+		if !ok {
+			continue
+		}
+
+		isDefault, _ := population["default"].(bool)
+		defaults[id] = isDefault
+	}
+
+	return defaults
+}
+
+func flattenPopulation(population pingone.Population, defaults map[string]bool) map[string]interface{} {
+	return map[string]interface{}{
+		"population_id": population.GetId(),
+		"name":          population.GetName(),
+		"description":   population.GetDescription(),
+		"user_count":    population.GetUserCount(),
+		"default":       defaults[population.GetId()],
+	}
+}
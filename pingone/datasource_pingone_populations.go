@@ -0,0 +1,130 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourcePopulations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourcePopulationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"populations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: populationComputedSchema(),
+				},
+			},
+		},
+	}
+}
+
+func datasourcePopulationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	filters := make([]string, 0)
+
+	if v, ok := d.GetOk("filter"); ok {
+		filters = append(filters, fmt.Sprintf("(%s)", v.(string)))
+	}
+
+	if v, ok := d.GetOk("name_prefix"); ok {
+		filters = append(filters, fmt.Sprintf("name sw %s", scimFilterValue(v.(string))))
+	}
+
+	filter := strings.Join(filters, " and ")
+
+	log.Printf("[INFO] Reading PingOne Populations: filter %s", filter)
+
+	limit := int32(1000)
+	request := api_client.ManagementAPIsPopulationsApi.ReadAllPopulations(ctx, envID).Limit(limit)
+	if filter != "" {
+		request = request.Filter(filter)
+	}
+
+	_, r, err := request.Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.ReadAllPopulations``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	ids := make([]string, 0)
+	populations := make([]interface{}, 0)
+
+	items, err := readAllPages(ctx, api_client, r, "populations")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the populations json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	resp := make([]pingone.Population, 0)
+
+	if err := decodeItems(items, &resp); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal populations json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	defaults := populationDefaults(items)
+
+	for _, population := range resp {
+		ids = append(ids, population.GetId())
+		populations = append(populations, flattenPopulation(population, defaults))
+	}
+
+	log.Printf("Populations found: %d", len(ids))
+
+	d.SetId(fmt.Sprintf("%s/%d", envID, schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("populations", populations)
+
+	return diags
+}
@@ -11,21 +11,65 @@ import (
 )
 
 func datasourceRole() *schema.Resource {
+
+	roleSchema := roleComputedSchema()
+
+	roleSchema["role_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"role_id", "name"},
+	}
+	roleSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"role_id", "name"},
+	}
+
 	return &schema.Resource{
 		ReadContext: datasourceRoleRead,
 
-		Schema: map[string]*schema.Schema{
-			"role_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-			},
-			"name": {
-				Type:     schema.TypeString,
-				Optional: true,
+		Schema: roleSchema,
+	}
+}
+
+// Attributes returned for each role, shared between the pingone_role and pingone_roles data sources
+func roleComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"role_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"applicable_to": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
 			},
-			"description": {
-				Type:     schema.TypeString,
-				Computed: true,
+		},
+		"permissions": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"classifier": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
 			},
 		},
 	}
@@ -45,27 +89,36 @@ func datasourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interf
 	var resp pingone.Role
 	if roleName != "" {
 
-		respList, r, err := api_client.ManagementAPIsRolesApi.ReadAllRoles(ctx).Execute()
+		roles, err := p1Client.getRoles(ctx)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsRolesApi.ReadAllRoles``: %v", err),
-				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+				Summary:  "Cannot read the PingOne roles",
+				Detail:   fmt.Sprintf("Full error: %v\n", err),
 			})
 
 			return diags
 		}
 
-		for _, v := range respList.Embedded.GetRoles() {
+		for _, v := range roles {
 			if v.GetName() == roleName {
 				resp = v
-				log.Printf("Role found %s", resp.GetName())
+				break
 			}
 		}
 
+		if resp.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot find role with name %s", roleName),
+			})
+
+			return diags
+		}
+
 	} else {
 
-		resp, r, err := api_client.ManagementAPIsRolesApi.ReadOneRole(ctx, roleID).Execute()
+		respOne, r, err := api_client.ManagementAPIsRolesApi.ReadOneRole(ctx, roleID).Execute()
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
@@ -75,13 +128,35 @@ func datasourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interf
 
 			return diags
 		}
-		log.Printf("Role found %s", resp.GetName())
+
+		resp = respOne
 	}
 
+	log.Printf("Role found %s", resp.GetName())
+
 	d.SetId(resp.GetId())
-	d.Set("role_id", resp.GetId())
-	d.Set("name", resp.GetName())
-	d.Set("description", resp.GetDescription())
+	for k, v := range flattenRole(resp) {
+		d.Set(k, v)
+	}
 
 	return diags
 }
+
+func flattenRole(role pingone.Role) map[string]interface{} {
+
+	permissions := make([]interface{}, 0, len(role.GetPermissions()))
+	for _, permission := range role.GetPermissions() {
+		permissions = append(permissions, map[string]interface{}{
+			"classifier":  permission.GetClassifier(),
+			"description": permission.GetDescription(),
+		})
+	}
+
+	return map[string]interface{}{
+		"role_id":       role.GetId(),
+		"name":          role.GetName(),
+		"description":   role.GetDescription(),
+		"applicable_to": role.GetApplicableTo(),
+		"permissions":   permissions,
+	}
+}
@@ -0,0 +1,98 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func datasourceRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceRolesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"applicable_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ORGANIZATION", "ENVIRONMENT", "POPULATION", "APPLICATION"}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: roleComputedSchema(),
+				},
+			},
+		},
+	}
+}
+
+func datasourceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	var diags diag.Diagnostics
+
+	resp, err := p1Client.getRoles(ctx)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read the PingOne roles",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	namePrefix := d.Get("name_prefix").(string)
+	applicableTo := d.Get("applicable_to").(string)
+
+	ids := make([]string, 0)
+	roles := make([]interface{}, 0)
+
+	for _, role := range resp {
+
+		if !strings.HasPrefix(role.GetName(), namePrefix) {
+			continue
+		}
+
+		if applicableTo != "" {
+			applicable := false
+			for _, v := range role.GetApplicableTo() {
+				if v == applicableTo {
+					applicable = true
+					break
+				}
+			}
+
+			if !applicable {
+				continue
+			}
+		}
+
+		ids = append(ids, role.GetId())
+		roles = append(roles, flattenRole(role))
+	}
+
+	log.Printf("Roles found: %d", len(ids))
+
+	d.SetId(fmt.Sprintf("%d", schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("roles", roles)
+
+	return diags
+}
@@ -0,0 +1,182 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceSchemaAttributes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceSchemaAttributesRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"schema_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CORE", "STANDARD", "CUSTOM"}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"attributes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"unique": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"multivalued": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"ldap_attribute": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"schema_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceSchemaAttributesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	schemaID := d.Get("schema_id").(string)
+	schemaType := d.Get("schema_type").(string)
+
+	resp, listDiags := readAllSchemaAttributeItems(ctx, api_client, envID, schemaID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ids := make([]string, 0)
+	names := make([]string, 0)
+	attributes := make([]interface{}, 0)
+
+	for _, v := range resp {
+		attribute, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if schemaType != "" && attribute["schemaType"] != schemaType {
+			continue
+		}
+
+		id, _ := attribute["id"].(string)
+		name, _ := attribute["name"].(string)
+
+		ids = append(ids, id)
+		names = append(names, name)
+		attributes = append(attributes, flattenSchemaAttributesItem(id, name, attribute, attribute))
+
+		// Sub-attributes are listed with dotted names such as `name.given`, in the same form that
+		// pingone_schema_attribute_override takes them.  They don't have IDs of their own, so they are only listed in
+		// `names` and `attributes`, with the `attribute_id` of their top level attribute
+		if v, ok := attribute["subAttributes"].([]interface{}); ok {
+			for _, v1 := range v {
+				subAttribute, ok := v1.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				subAttributeName := fmt.Sprintf("%s.%s", name, subAttribute["name"])
+
+				names = append(names, subAttributeName)
+				attributes = append(attributes, flattenSchemaAttributesItem(id, subAttributeName, attribute, subAttribute))
+			}
+		}
+	}
+
+	log.Printf("Schema attributes found: %d", len(ids))
+
+	d.SetId(fmt.Sprintf("%s/%s/%d", envID, schemaID, schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("attributes", attributes)
+
+	return diags
+}
+
+func flattenSchemaAttributesItem(id, name string, attribute, in map[string]interface{}) map[string]interface{} {
+	item := flattenSchemaAttributeOverrideValues(in)
+
+	item["attribute_id"] = id
+	item["name"] = name
+	item["type"] = in["type"]
+	item["unique"] = in["unique"]
+	item["multivalued"] = in["multiValued"]
+	item["ldap_attribute"] = in["ldapAttribute"]
+	item["schema_type"] = attribute["schemaType"]
+
+	return item
+}
@@ -0,0 +1,178 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceUser() *schema.Resource {
+
+	userSchema := userComputedSchema()
+
+	userSchema["environment_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	userSchema["user_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"user_id", "username", "email"},
+	}
+	userSchema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"user_id", "username", "email"},
+	}
+	userSchema["email"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"user_id", "username", "email"},
+	}
+
+	return &schema.Resource{
+		ReadContext: datasourceUserRead,
+
+		Schema: userSchema,
+	}
+}
+
+// Attributes returned for each user, shared between the pingone_user and pingone_users data sources
+func userComputedSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"user_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"username": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"email": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"population_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+		"given_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"family_name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"external_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"account_status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"mfa_enabled": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+}
+
+func datasourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	userID := d.Get("user_id").(string)
+
+	var filter string
+	if v, ok := d.GetOk("username"); ok {
+		filter = fmt.Sprintf("username eq %s", scimFilterValue(v.(string)))
+	} else if v, ok := d.GetOk("email"); ok {
+		filter = fmt.Sprintf("email eq %s", scimFilterValue(v.(string)))
+	}
+
+	var resp pingone.User
+	if filter != "" {
+
+		limit := int32(1)
+
+		respList, r, err := api_client.ManagementAPIsUsersUsersApi.ReadAllUsers(ctx, envID).Filter(filter).Limit(limit).Execute()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsUsersUsersApi.ReadAllUsers``: %v", err),
+				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+			})
+
+			return diags
+		}
+
+		users := respList.Embedded.GetUsers()
+		if len(users) == 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Cannot find user matching filter %s", filter),
+			})
+
+			return diags
+		}
+
+		resp = users[0]
+
+	} else {
+
+		respOne, r, err := api_client.ManagementAPIsUsersUsersApi.ReadUser(ctx, envID, userID).Execute()
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsUsersUsersApi.ReadUser``: %v", err),
+				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+			})
+
+			return diags
+		}
+
+		resp = respOne
+	}
+
+	log.Printf("User found %s", resp.GetUsername())
+
+	d.SetId(resp.GetId())
+	for k, v := range flattenUser(resp) {
+		d.Set(k, v)
+	}
+
+	return diags
+}
+
+func flattenUser(user pingone.User) map[string]interface{} {
+	return map[string]interface{}{
+		"user_id":        user.GetId(),
+		"username":       user.GetUsername(),
+		"email":          user.GetEmail(),
+		"population_id":  user.GetPopulation().Id,
+		"enabled":        user.GetEnabled(),
+		"given_name":     user.Name.GetGiven(),
+		"family_name":    user.Name.GetFamily(),
+		"external_id":    user.GetExternalId(),
+		"account_status": user.Account.GetStatus(),
+		"mfa_enabled":    user.GetMfaEnabled(),
+	}
+}
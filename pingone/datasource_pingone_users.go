@@ -0,0 +1,137 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func datasourceUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceUsersRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"filter": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"population_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: userComputedSchema(),
+				},
+			},
+		},
+	}
+}
+
+func datasourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	filters := make([]string, 0)
+
+	if v, ok := d.GetOk("filter"); ok {
+		filters = append(filters, fmt.Sprintf("(%s)", v.(string)))
+	}
+
+	if v, ok := d.GetOk("population_id"); ok {
+		filters = append(filters, fmt.Sprintf("population.id eq %s", scimFilterValue(v.(string))))
+	}
+
+	// GetOkExists is needed so that an explicit `enabled = false` is still applied as a filter
+	if v, ok := d.GetOkExists("enabled"); ok {
+		filters = append(filters, fmt.Sprintf("enabled eq %t", v.(bool)))
+	}
+
+	filter := strings.Join(filters, " and ")
+
+	log.Printf("[INFO] Reading PingOne Users: filter %s", filter)
+
+	limit := int32(1000)
+	request := api_client.ManagementAPIsUsersUsersApi.ReadAllUsers(ctx, envID).Limit(limit)
+	if filter != "" {
+		request = request.Filter(filter)
+	}
+
+	_, r, err := request.Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsUsersUsersApi.ReadAllUsers``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	ids := make([]string, 0)
+	users := make([]interface{}, 0)
+
+	items, err := readAllPages(ctx, api_client, r, "users")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the users json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	resp := make([]pingone.User, 0)
+
+	if err := decodeItems(items, &resp); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal users json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	for _, user := range resp {
+		ids = append(ids, user.GetId())
+		users = append(users, flattenUser(user))
+	}
+
+	log.Printf("Users found: %d", len(ids))
+
+	d.SetId(fmt.Sprintf("%s/%d", envID, schema.HashString(strings.Join(ids, ","))))
+	d.Set("ids", ids)
+	d.Set("users", users)
+
+	return diags
+}
@@ -33,36 +33,65 @@ func Provider() *schema.Provider {
 				Type:         schema.TypeString,
 				Required:     true,
 				Description:  descriptions["region"],
-				ValidateFunc: validation.StringInSlice([]string{"EU", "US", "ASIA", "CA"}, false),
+				ValidateFunc: validation.StringInSlice(regionCodes(), false),
+			},
+			"validate_attribute_references": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["validate_attribute_references"],
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"pingone_application_attribute_mapping": resourceApplicationAttributeMapping(),
-			"pingone_application_oidc":              resourceApplicationOIDC(),
-			"pingone_application_resource_grant":    resourceApplicationResourceGrant(),
-			"pingone_application_role_assignment":   resourceApplicationRoleAssignment(),
-			"pingone_environment":                   resourceEnvironment(),
-			"pingone_gateway_credential":            resourceGatewayCredential(),
-			"pingone_gateway_role_assignment":       resourceGatewayRoleAssignment(),
-			"pingone_gateway":                       resourceGateway(),
-			"pingone_group":                         resourceGroup(),
-			"pingone_population":                    resourcePopulation(),
-			"pingone_resource":                      resourceResource(),
-			"pingone_resource_scope":                resourceResourceScope(),
-			"pingone_user_role_assignment":          resourceUserRoleAssignment(),
-			"pingone_schema_attribute":              resourceSchemaAttribute(),
+			"pingone_agreement":                      resourceAgreement(),
+			"pingone_agreement_localization":         resourceAgreementLocalization(),
+			"pingone_agreement_revision":             resourceAgreementRevision(),
+			"pingone_application_attribute_mapping":  resourceApplicationAttributeMapping(),
+			"pingone_application_attribute_mappings": resourceApplicationAttributeMappings(),
+			"pingone_application_oidc":               resourceApplicationOIDC(),
+			"pingone_application_resource_grant":     resourceApplicationResourceGrant(),
+			"pingone_application_role_assignment":    resourceApplicationRoleAssignment(),
+			"pingone_environment":                    resourceEnvironment(),
+			"pingone_gateway_credential":             resourceGatewayCredential(),
+			"pingone_gateway_role_assignment":        resourceGatewayRoleAssignment(),
+			"pingone_gateway":                        resourceGateway(),
+			"pingone_group":                          resourceGroup(),
+			"pingone_language":                       resourceLanguage(),
+			"pingone_language_update":                resourceLanguageUpdate(),
+			"pingone_population":                     resourcePopulation(),
+			"pingone_resource":                       resourceResource(),
+			"pingone_resource_scope":                 resourceResourceScope(),
+			"pingone_trusted_email_address":          resourceTrustedEmailAddress(),
+			"pingone_trusted_email_domain":           resourceTrustedEmailDomain(),
+			"pingone_user_role_assignment":           resourceUserRoleAssignment(),
+			"pingone_schema_attribute":               resourceSchemaAttribute(),
+			"pingone_schema_attribute_override":      resourceSchemaAttributeOverride(),
+			"pingone_webhook":                        resourceWebhook(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
+			"pingone_application":                 datasourceApplication(),
+			"pingone_applications":                datasourceApplications(),
 			"pingone_application_system":          datasourceApplicationSystem(),
 			"pingone_application_oidc_secret":     datasourceApplicationSecret(),
 			"pingone_application_resource_grants": datasourceApplicationResourceGrants(),
 			"pingone_environment":                 datasourceEnvironment(),
 			"pingone_environments":                datasourceEnvironments(),
+			"pingone_gateway_credentials":         datasourceGatewayCredentials(),
+			"pingone_gateway_instances":           datasourceGatewayInstances(),
 			"pingone_group":                       datasourceGroup(),
+			"pingone_license":                     datasourceLicense(),
+			"pingone_licenses":                    datasourceLicenses(),
+			"pingone_organization":                datasourceOrganization(),
+			"pingone_population":                  datasourcePopulation(),
+			"pingone_populations":                 datasourcePopulations(),
 			"pingone_resource_scope":              datasourceResourceScope(),
 			"pingone_resource":                    datasourceResource(),
 			"pingone_role":                        datasourceRole(),
+			"pingone_roles":                       datasourceRoles(),
 			"pingone_schema":                      datasourceSchema(),
+			"pingone_schema_attributes":           datasourceSchemaAttributes(),
+			"pingone_user":                        datasourceUser(),
+			"pingone_users":                       datasourceUsers(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
@@ -77,7 +106,9 @@ func init() {
 		"client_id":      "Client ID for the worker app client",
 		"client_secret":  "Client secret for the worker app client",
 		"environment_id": "Environment ID for the worker app client",
-		"region":         "The PingOne region to use.  Options are EU, US, ASIA, CA",
+		"region":         "The PingOne region to use.  Options are EU, US (or NA), ASIA (or AP), CA",
+		"validate_attribute_references": "Check the user attributes referenced in attribute mapping expressions against the environment's User schema during plan.  " +
+			"Requires the worker app to be able to read schemas in the target environments",
 	}
 }
 
@@ -90,13 +121,15 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		ClientSecret:  d.Get("client_secret").(string),
 		EnvironmentID: d.Get("environment_id").(string),
 		Region:        d.Get("region").(string),
+
+		ValidateAttributeReferences: d.Get("validate_attribute_references").(bool),
 	}
 
 	client, err := config.ApiClient(ctx)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  "Error when getting access token",
+			Summary:  "Error when configuring the PingOne client",
 			Detail:   fmt.Sprintf("Error when getting access token`: %v", err),
 		})
 
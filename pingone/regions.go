@@ -0,0 +1,87 @@
+package pingone
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// p1Region describes a PingOne region.  The provider configuration has historically used the provider code (e.g. US)
+// while the environments API uses the API code (e.g. NA), so both spellings are accepted wherever a region is configured
+type p1Region struct {
+	ProviderCode string
+	APICode      string
+	URLSuffix    string
+}
+
+var p1Regions = []p1Region{
+	{
+		ProviderCode: "US",
+		APICode:      "NA",
+		URLSuffix:    "com",
+	},
+	{
+		ProviderCode: "EU",
+		APICode:      "EU",
+		URLSuffix:    "eu",
+	},
+	{
+		ProviderCode: "ASIA",
+		APICode:      "AP",
+		URLSuffix:    "asia",
+	},
+	{
+		ProviderCode: "CA",
+		APICode:      "CA",
+		URLSuffix:    "ca",
+	},
+}
+
+func findRegion(code string) (p1Region, error) {
+	for _, region := range p1Regions {
+		if code == region.ProviderCode || code == region.APICode {
+			return region, nil
+		}
+	}
+
+	return p1Region{}, fmt.Errorf("unknown region \"%s\", should be one of %v", code, regionCodes())
+}
+
+// Both the provider and API spellings, for use in schema validation
+func regionCodes() []string {
+	codes := make([]string, 0)
+
+	for _, region := range p1Regions {
+		codes = append(codes, region.ProviderCode)
+
+		if region.APICode != region.ProviderCode {
+			codes = append(codes, region.APICode)
+		}
+	}
+
+	return codes
+}
+
+func suppressEquivalentRegion(k, old, new string, d *schema.ResourceData) bool {
+	oldRegion, err := findRegion(old)
+	if err != nil {
+		return false
+	}
+
+	newRegion, err := findRegion(new)
+	if err != nil {
+		return false
+	}
+
+	return oldRegion.APICode == newRegion.APICode
+}
+
+// State always holds the API spelling of the region, so the same value is returned by the resource and data sources
+func flattenRegion(code string) string {
+	region, err := findRegion(code)
+	if err != nil {
+		return code
+	}
+
+	return region.APICode
+}
@@ -0,0 +1,238 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceAgreement() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgreementCreate,
+		ReadContext:   resourceAgreementRead,
+		UpdateContext: resourceAgreementUpdate,
+		DeleteContext: resourceAgreementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgreementImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"reconsent_period_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"total_consents": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expired_consents": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAgreementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	agreement := expandAgreement(d)
+
+	log.Printf("[INFO] Creating PingOne Agreement: name %s", agreement["name"])
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsPost(ctx, envID).Body(agreement).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal agreement json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceAgreementRead(ctx, d, meta)
+}
+
+func resourceAgreementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	agreementID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDGet(ctx, envID, agreementID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Agreement %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal agreement json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("name", resp["name"])
+	d.Set("description", resp["description"])
+	d.Set("enabled", resp["enabled"])
+	d.Set("reconsent_period_days", resp["reconsentPeriodDays"])
+	d.Set("total_consents", resp["totalConsents"])
+	d.Set("expired_consents", resp["expiredConsents"])
+
+	return diags
+}
+
+func resourceAgreementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	agreementID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	agreement := expandAgreement(d)
+
+	log.Printf("[INFO] Updating PingOne Agreement: name %s", agreement["name"])
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDPut(ctx, envID, agreementID).Body(agreement).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDPut``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	return resourceAgreementRead(ctx, d, meta)
+}
+
+func resourceAgreementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	agreementID := d.Id()
+
+	_, err := api_client.ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDDelete(ctx, envID, agreementID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDDelete``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceAgreementImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/agreementID\"", d.Id())
+	}
+
+	envID, agreementID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.SetId(agreementID)
+
+	resourceAgreementRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAgreement(d *schema.ResourceData) map[string]interface{} {
+
+	agreement := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"enabled": d.Get("enabled").(bool),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		agreement["description"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("reconsent_period_days"); ok {
+		agreement["reconsentPeriodDays"] = v.(int)
+	}
+
+	return agreement
+}
@@ -0,0 +1,235 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceAgreementLocalization() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgreementLocalizationCreate,
+		ReadContext:   resourceAgreementLocalizationRead,
+		UpdateContext: resourceAgreementLocalizationUpdate,
+		DeleteContext: resourceAgreementLocalizationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgreementLocalizationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agreement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"current_revision_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAgreementLocalizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	agreementID := d.Get("agreement_id").(string)
+
+	agreementLanguage := expandAgreementLocalization(d)
+	agreementLanguage["locale"] = d.Get("locale").(string)
+
+	log.Printf("[INFO] Creating PingOne Agreement Localization: agreement %s, locale %s", agreementID, agreementLanguage["locale"])
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesPost(ctx, envID, agreementID).Body(agreementLanguage).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal agreement localization json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceAgreementLocalizationRead(ctx, d, meta)
+}
+
+func resourceAgreementLocalizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	agreementLanguageID := d.Id()
+	envID := d.Get("environment_id").(string)
+	agreementID := d.Get("agreement_id").(string)
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDGet(ctx, envID, agreementID, agreementLanguageID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Agreement Localization %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal agreement localization json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("locale", resp["locale"])
+	d.Set("display_name", resp["displayName"])
+	d.Set("enabled", resp["enabled"])
+
+	if v, ok := resp["currentRevision"].(map[string]interface{}); ok {
+		d.Set("current_revision_id", v["id"])
+	} else {
+		d.Set("current_revision_id", "")
+	}
+
+	return diags
+}
+
+func resourceAgreementLocalizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	agreementLanguageID := d.Id()
+	envID := d.Get("environment_id").(string)
+	agreementID := d.Get("agreement_id").(string)
+
+	agreementLanguage := expandAgreementLocalization(d)
+
+	log.Printf("[INFO] Updating PingOne Agreement Localization: agreement %s, locale %s", agreementID, d.Get("locale").(string))
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDPut(ctx, envID, agreementID, agreementLanguageID).Body(agreementLanguage).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDPut``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	return resourceAgreementLocalizationRead(ctx, d, meta)
+}
+
+func resourceAgreementLocalizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	agreementID := d.Get("agreement_id").(string)
+
+	agreementLanguageID := d.Id()
+
+	_, err := api_client.ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDDelete(ctx, envID, agreementID, agreementLanguageID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementLanguagesResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDDelete``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceAgreementLocalizationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/agreementID/agreementLocalizationID\"", d.Id())
+	}
+
+	envID, agreementID, agreementLanguageID := attributes[0], attributes[1], attributes[2]
+
+	d.Set("environment_id", envID)
+	d.Set("agreement_id", agreementID)
+	d.SetId(agreementLanguageID)
+
+	resourceAgreementLocalizationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAgreementLocalization(d *schema.ResourceData) map[string]interface{} {
+
+	agreementLanguage := map[string]interface{}{
+		"displayName": d.Get("display_name").(string),
+		"enabled":     d.Get("enabled").(bool),
+	}
+
+	return agreementLanguage
+}
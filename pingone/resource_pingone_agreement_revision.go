@@ -0,0 +1,234 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceAgreementRevision() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAgreementRevisionCreate,
+		ReadContext:   resourceAgreementRevisionRead,
+		DeleteContext: resourceAgreementRevisionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAgreementRevisionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agreement_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agreement_localization_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"content_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "text/html",
+				ValidateFunc: validation.StringInSlice([]string{"text/html", "text/plain"}, false),
+			},
+			"text": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"effective_at": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+			"not_valid_after": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+			},
+			"require_reconsent": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAgreementRevisionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	agreementID := d.Get("agreement_id").(string)
+	agreementLanguageID := d.Get("agreement_localization_id").(string)
+
+	revision := map[string]interface{}{
+		"contentType":      d.Get("content_type").(string),
+		"text":             d.Get("text").(string),
+		"requireReconsent": d.Get("require_reconsent").(bool),
+	}
+
+	if v, ok := d.GetOk("effective_at"); ok {
+		revision["effectiveAt"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("not_valid_after"); ok {
+		revision["notValidAfter"] = v.(string)
+	}
+
+	log.Printf("[INFO] Creating PingOne Agreement Revision: agreement %s, localization %s", agreementID, agreementLanguageID)
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementRevisionsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDRevisionsPost(ctx, envID, agreementID, agreementLanguageID).Body(revision).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementRevisionsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDRevisionsPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal agreement revision json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceAgreementRevisionRead(ctx, d, meta)
+}
+
+func resourceAgreementRevisionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	revisionID := d.Id()
+	envID := d.Get("environment_id").(string)
+	agreementID := d.Get("agreement_id").(string)
+	agreementLanguageID := d.Get("agreement_localization_id").(string)
+
+	r, err := api_client.ManagementAPIsAgreementManagementAgreementRevisionsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDRevisionsRevisionIDGet(ctx, envID, agreementID, agreementLanguageID, revisionID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Agreement Revision %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsAgreementManagementAgreementRevisionsResourcesApi.V1EnvironmentsEnvIDAgreementsAgreementIDLanguagesLanguageIDRevisionsRevisionIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal agreement revision json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("content_type", resp["contentType"])
+	d.Set("effective_at", resp["effectiveAt"])
+	d.Set("not_valid_after", resp["notValidAfter"])
+	d.Set("require_reconsent", resp["requireReconsent"])
+
+	// The revision text isn't always returned on read, so leave the configured value in place
+	if v, ok := resp["text"]; ok {
+		d.Set("text", v)
+	}
+
+	return diags
+}
+
+func resourceAgreementRevisionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The SDK revision delete function has no path parameters so can't be called.  Revisions are only removed from state
+	// until the function is cleansed in the OpenAPI spec
+	diags = append(diags, diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Agreement revision %s has been removed from state but not deleted from the platform", d.Id()),
+		Detail:   "Revisions that have not yet become effective must be removed in the admin console.",
+	})
+
+	d.SetId("")
+
+	return diags
+}
+
+func resourceAgreementRevisionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 4)
+
+	if len(attributes) != 4 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/agreementID/agreementLocalizationID/revisionID\"", d.Id())
+	}
+
+	envID, agreementID, agreementLanguageID, revisionID := attributes[0], attributes[1], attributes[2], attributes[3]
+
+	d.Set("environment_id", envID)
+	d.Set("agreement_id", agreementID)
+	d.Set("agreement_localization_id", agreementLanguageID)
+	d.SetId(revisionID)
+
+	resourceAgreementRevisionRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func suppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
@@ -20,6 +20,7 @@ func resourceApplicationAttributeMapping() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceApplicationAttributeMappingImport,
 		},
+		CustomizeDiff: resourceApplicationAttributeMappingCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
@@ -41,17 +42,38 @@ func resourceApplicationAttributeMapping() *schema.Resource {
 				Required: true,
 			},
 			"value": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateAttributeExpression,
 			},
 			"mapping_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"original": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// OIDC claims that are set by the platform and can't be mapped.  The `sub` claim is also platform managed but exists as
+// a CORE mapping on every OIDC application, so it is adopted rather than created
+var applicationAttributeMappingReservedOIDCNames = []string{"acr", "amr", "at_hash", "aud", "auth_time", "azp", "client_id", "exp", "iat", "iss", "jti", "nbf", "nonce", "org", "scope", "sid"}
+
 func resourceApplicationAttributeMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	p1Client := meta.(*p1Client)
 	api_client := p1Client.APIClient
@@ -74,6 +96,39 @@ func resourceApplicationAttributeMappingCreate(ctx context.Context, d *schema.Re
 		return diags
 	}
 
+	mappings, listDiags := readAllApplicationAttributeMappings(ctx, api_client, envID, appID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// CORE and SCOPE mappings always exist on the application and can't be created or deleted, so they are adopted and
+	// their values at adoption kept so that they can be restored when the resource is destroyed
+	for _, mapping := range mappings {
+		if !strings.EqualFold(mapping.GetName(), applicationAttributeMapping.GetName()) || mapping.GetMappingType() == "CUSTOM" {
+			continue
+		}
+
+		log.Printf("[INFO] Adopting PingOne Application Attribute: name %s, mapping type %s", mapping.GetName(), mapping.GetMappingType())
+
+		d.SetId(mapping.GetId())
+		d.Set("original", []interface{}{
+			map[string]interface{}{
+				"value":    mapping.GetValue(),
+				"required": mapping.GetRequired(),
+			},
+		})
+
+		applicationAttributeMapping.SetName(mapping.GetName())
+
+		diags = append(diags, updateApplicationAttributeMapping(ctx, api_client, envID, appID, d.Id(), applicationAttributeMapping)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		return resourceApplicationAttributeMappingRead(ctx, d, meta)
+	}
+
 	log.Printf("[INFO] Creating PingOne Application Attribute: name %s", applicationAttributeMapping.GetName())
 
 	resp, r, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.CreateApplicationAttributeMapping(ctx, envID, appID).ApplicationAttributeMapping(applicationAttributeMapping).Execute()
@@ -182,6 +237,10 @@ func resourceApplicationAttributeMappingDelete(ctx context.Context, d *schema.Re
 
 	attrMappingID := d.Id()
 
+	if mappingType := d.Get("mapping_type").(string); mappingType != "" && mappingType != "CUSTOM" {
+		return resetApplicationAttributeMapping(ctx, api_client, d)
+	}
+
 	_, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.DeleteApplicationAttributeMapping(ctx, envID, appID, attrMappingID).Execute()
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -195,10 +254,40 @@ func resourceApplicationAttributeMappingDelete(ctx context.Context, d *schema.Re
 	return nil
 }
 
+func resourceApplicationAttributeMappingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	// Platform managed mappings can't be renamed
+	if d.Id() != "" && d.HasChange("name") {
+		if mappingType := d.Get("mapping_type").(string); mappingType != "" && mappingType != "CUSTOM" {
+			if err := d.ForceNew("name"); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.NewValueKnown("name") {
+		if err := validateApplicationAttributeMappingName(ctx, d, meta.(*p1Client), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	if !d.NewValueKnown("value") {
+		return nil
+	}
+
+	// The syntax has already been checked by the value's validation function
+	references, err := parseAttributeExpressionTemplate(d.Get("value").(string))
+	if err != nil {
+		return nil
+	}
+
+	return validateUserAttributeReferences(ctx, d, meta.(*p1Client), references)
+}
+
 func resourceApplicationAttributeMappingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 3)
 
-	if len(attributes) != 2 {
+	if len(attributes) != 3 {
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/appID/attributeMappingID\"", d.Id())
 	}
 
@@ -210,6 +299,16 @@ func resourceApplicationAttributeMappingImport(ctx context.Context, d *schema.Re
 
 	resourceApplicationAttributeMappingRead(ctx, d, meta)
 
+	// The values of platform managed mappings at import are taken as the values to restore on destroy
+	if mappingType := d.Get("mapping_type").(string); mappingType != "" && mappingType != "CUSTOM" {
+		d.Set("original", []interface{}{
+			map[string]interface{}{
+				"value":    d.Get("value").(string),
+				"required": d.Get("required").(bool),
+			},
+		})
+	}
+
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -219,3 +318,128 @@ func expandApplicationAttributeMapping(d *schema.ResourceData) (pingone.Applicat
 
 	return applicationAttributeMapping, nil
 }
+
+// Reserved claims are only checked for OIDC applications.  Where the application is created in the same run, it is
+// taken to be OIDC as that is the only application type the provider manages
+func validateApplicationAttributeMappingName(ctx context.Context, d *schema.ResourceDiff, p1Client *p1Client, name string) error {
+
+	reserved := false
+	for _, v := range applicationAttributeMappingReservedOIDCNames {
+		if v == name {
+			reserved = true
+			break
+		}
+	}
+
+	if !reserved {
+		return nil
+	}
+
+	if d.NewValueKnown("environment_id") && d.NewValueKnown("application_id") {
+		ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+			"suffix": p1Client.regionSuffix,
+		})
+
+		resp, _, err := p1Client.APIClient.ManagementAPIsApplicationsApplicationsApi.ReadOneApplication(ctx, d.Get("environment_id").(string), d.Get("application_id").(string)).Execute()
+		if err != nil {
+			log.Printf("[WARN] Cannot read application %s, skipping the reserved claim check: %v", d.Get("application_id").(string), err)
+			return nil
+		}
+
+		application, err := flattenApplication(resp)
+		if err != nil {
+			log.Printf("[WARN] Cannot flatten application %s, skipping the reserved claim check: %v", d.Get("application_id").(string), err)
+			return nil
+		}
+
+		if application["protocol"].(string) != "OPENID_CONNECT" {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("`%s` is a reserved OIDC claim that is set by the platform and can't be mapped", name)
+}
+
+// Restores a platform managed mapping to the values it had when it was adopted
+func resetApplicationAttributeMapping(ctx context.Context, api_client *pingone.APIClient, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	appID := d.Get("application_id").(string)
+
+	v, ok := d.GetOk("original")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Application attribute mapping %s has no original values to restore", d.Get("name").(string)),
+			Detail:   "The mapping has been removed from state and has been left unchanged\n",
+		})
+
+		return diags
+	}
+
+	original := v.([]interface{})[0].(map[string]interface{})
+
+	log.Printf("[INFO] Resetting PingOne Application Attribute: name %s", d.Get("name").(string))
+
+	applicationAttributeMapping := *pingone.NewApplicationAttributeMapping(d.Get("name").(string), original["required"].(bool), original["value"].(string))
+
+	return updateApplicationAttributeMapping(ctx, api_client, envID, appID, d.Id(), applicationAttributeMapping)
+}
+
+func updateApplicationAttributeMapping(ctx context.Context, api_client *pingone.APIClient, envID, appID, attrMappingID string, applicationAttributeMapping pingone.ApplicationAttributeMapping) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, r, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.UpdateApplicationAttributeMapping(ctx, envID, appID, attrMappingID).ApplicationAttributeMapping(applicationAttributeMapping).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationAttributeMappingApi.UpdateApplicationAttributeMapping``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	return diags
+}
+
+func readAllApplicationAttributeMappings(ctx context.Context, api_client *pingone.APIClient, envID, appID string) ([]pingone.ApplicationAttributeMapping, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	_, r, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.ReadAllApplicationAttributeMappings(ctx, envID, appID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationAttributeMappingApi.ReadAllApplicationAttributeMappings``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return nil, diags
+	}
+
+	mappings := make([]pingone.ApplicationAttributeMapping, 0)
+
+	items, err := readAllPages(ctx, api_client, r, "attributes")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the application attribute mappings json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	if err := decodeItems(items, &mappings); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal application attribute mappings json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	return mappings, diags
+}
@@ -0,0 +1,440 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+// Authoritative set of attribute mappings for an application.  Custom mappings that aren't in the configuration are
+// removed, while platform managed (CORE and SCOPE) mappings are only changed when they are listed, and are reset to
+// their original values when they are removed from the configuration.  Mappings are a set of `mapping` blocks rather
+// than a map of claim name to value and required flag, as the SDK's TypeMap can only hold primitive values
+func resourceApplicationAttributeMappings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApplicationAttributeMappingsCreate,
+		ReadContext:   resourceApplicationAttributeMappingsRead,
+		UpdateContext: resourceApplicationAttributeMappingsUpdate,
+		DeleteContext: resourceApplicationAttributeMappingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceApplicationAttributeMappingsImport,
+		},
+		CustomizeDiff: resourceApplicationAttributeMappingsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateAttributeExpression,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"mapping_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"original": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceApplicationAttributeMappingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	d.SetId(d.Get("application_id").(string))
+
+	return resourceApplicationAttributeMappingsUpdate(ctx, d, meta)
+}
+
+func resourceApplicationAttributeMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	appID := d.Get("application_id").(string)
+
+	// The mappings are removed with the application
+	_, r, err := api_client.ManagementAPIsApplicationsApplicationsApi.ReadOneApplication(ctx, envID, appID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Application %s no longer exists", appID)
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationsApi.ReadOneApplication``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, listDiags := readAllApplicationAttributeMappings(ctx, api_client, envID, appID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	original := expandApplicationAttributeMappingsOriginal(d)
+
+	mappings := make([]interface{}, 0)
+	mappingIDs := make(map[string]interface{})
+
+	for _, mapping := range resp {
+
+		// Platform managed mappings are only tracked once they have been adopted
+		if _, ok := original[mapping.GetName()]; mapping.GetMappingType() != "CUSTOM" && !ok {
+			continue
+		}
+
+		mappings = append(mappings, map[string]interface{}{
+			"name":     mapping.GetName(),
+			"value":    mapping.GetValue(),
+			"required": mapping.GetRequired(),
+		})
+		mappingIDs[mapping.GetName()] = mapping.GetId()
+	}
+
+	d.Set("mapping", mappings)
+	d.Set("mapping_ids", mappingIDs)
+
+	return diags
+}
+
+func resourceApplicationAttributeMappingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	appID := d.Get("application_id").(string)
+
+	resp, listDiags := readAllApplicationAttributeMappings(ctx, api_client, envID, appID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	desired := expandApplicationAttributeMappings(d)
+	original := expandApplicationAttributeMappingsOriginal(d)
+
+	// Changes are applied against the full list from the API, so that mappings added outside of Terraform are removed.
+	// The original values of adopted mappings are saved on every exit so that a partial apply can still restore them
+	for _, mapping := range resp {
+
+		// Names are matched exactly, as names that only differ by case from a platform managed mapping are rejected at plan
+		// time
+		want, ok := desired[mapping.GetName()]
+		delete(desired, mapping.GetName())
+
+		if !ok {
+
+			if mapping.GetMappingType() == "CUSTOM" {
+				log.Printf("[INFO] Deleting PingOne Application Attribute: name %s", mapping.GetName())
+
+				r, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.DeleteApplicationAttributeMapping(ctx, envID, appID, mapping.GetId()).Execute()
+				if err != nil && (r == nil || r.StatusCode != 404) {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationAttributeMappingApi.DeleteApplicationAttributeMapping``: %v", err),
+					})
+
+					d.Set("original", flattenApplicationAttributeMappingsOriginal(original))
+					return diags
+				}
+
+				continue
+			}
+
+			if v, ok := original[mapping.GetName()]; ok {
+				log.Printf("[INFO] Resetting PingOne Application Attribute: name %s", mapping.GetName())
+
+				diags = append(diags, updateApplicationAttributeMapping(ctx, api_client, envID, appID, mapping.GetId(), *pingone.NewApplicationAttributeMapping(mapping.GetName(), v.GetRequired(), v.GetValue()))...)
+				if diags.HasError() {
+					d.Set("original", flattenApplicationAttributeMappingsOriginal(original))
+					return diags
+				}
+
+				delete(original, mapping.GetName())
+			}
+
+			continue
+		}
+
+		if mapping.GetMappingType() != "CUSTOM" {
+			if _, ok := original[mapping.GetName()]; !ok {
+				log.Printf("[INFO] Adopting PingOne Application Attribute: name %s, mapping type %s", mapping.GetName(), mapping.GetMappingType())
+				original[mapping.GetName()] = *pingone.NewApplicationAttributeMapping(mapping.GetName(), mapping.GetRequired(), mapping.GetValue())
+			}
+		}
+
+		if mapping.GetValue() == want.GetValue() && mapping.GetRequired() == want.GetRequired() {
+			continue
+		}
+
+		log.Printf("[INFO] Updating PingOne Application Attribute: name %s", mapping.GetName())
+
+		diags = append(diags, updateApplicationAttributeMapping(ctx, api_client, envID, appID, mapping.GetId(), *want)...)
+		if diags.HasError() {
+			d.Set("original", flattenApplicationAttributeMappingsOriginal(original))
+			return diags
+		}
+	}
+
+	d.Set("original", flattenApplicationAttributeMappingsOriginal(original))
+
+	for _, v := range desired {
+		log.Printf("[INFO] Creating PingOne Application Attribute: name %s", v.GetName())
+
+		_, r, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.CreateApplicationAttributeMapping(ctx, envID, appID).ApplicationAttributeMapping(*v).Execute()
+		if (err != nil) || (r.StatusCode != 201) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationAttributeMappingApi.CreateApplicationAttributeMapping``: %v", err),
+				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+			})
+
+			return diags
+		}
+	}
+
+	return resourceApplicationAttributeMappingsRead(ctx, d, meta)
+}
+
+func resourceApplicationAttributeMappingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	appID := d.Get("application_id").(string)
+
+	original := expandApplicationAttributeMappingsOriginal(d)
+	mappingIDs := d.Get("mapping_ids").(map[string]interface{})
+
+	for name, id := range mappingIDs {
+
+		if v, ok := original[name]; ok {
+			log.Printf("[INFO] Resetting PingOne Application Attribute: name %s", name)
+
+			diags = append(diags, updateApplicationAttributeMapping(ctx, api_client, envID, appID, id.(string), *pingone.NewApplicationAttributeMapping(name, v.GetRequired(), v.GetValue()))...)
+			if diags.HasError() {
+				return diags
+			}
+
+			continue
+		}
+
+		log.Printf("[INFO] Deleting PingOne Application Attribute: name %s", name)
+
+		r, err := api_client.ManagementAPIsApplicationsApplicationAttributeMappingApi.DeleteApplicationAttributeMapping(ctx, envID, appID, id.(string)).Execute()
+		if err != nil && (r == nil || r.StatusCode != 404) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsApplicationsApplicationAttributeMappingApi.DeleteApplicationAttributeMapping``: %v", err),
+			})
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func resourceApplicationAttributeMappingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	if !d.NewValueKnown("mapping") {
+		return nil
+	}
+
+	names := make(map[string]bool)
+	configuredNames := make([]string, 0)
+	references := make([]string, 0)
+
+	for _, v := range d.Get("mapping").(*schema.Set).List() {
+		mapping := v.(map[string]interface{})
+		name := mapping["name"].(string)
+
+		if names[strings.ToLower(name)] {
+			return fmt.Errorf("attribute mapping `%s` is defined more than once", name)
+		}
+		names[strings.ToLower(name)] = true
+		configuredNames = append(configuredNames, name)
+
+		if err := validateApplicationAttributeMappingName(ctx, d, meta.(*p1Client), name); err != nil {
+			return err
+		}
+
+		// The syntax has already been checked by the value's validation function
+		if mappingReferences, err := parseAttributeExpressionTemplate(mapping["value"].(string)); err == nil {
+			references = append(references, mappingReferences...)
+		}
+	}
+
+	if err := validateApplicationAttributeMappingsNameCase(ctx, d, meta.(*p1Client), configuredNames); err != nil {
+		return err
+	}
+
+	return validateUserAttributeReferences(ctx, d, meta.(*p1Client), references)
+}
+
+// Platform managed mappings can't be renamed, so a configured name that only differs by case from one of them can
+// neither be adopted nor created.  Custom mappings are replaced when renamed
+func validateApplicationAttributeMappingsNameCase(ctx context.Context, d *schema.ResourceDiff, p1Client *p1Client, names []string) error {
+
+	if len(names) == 0 || !d.NewValueKnown("environment_id") || !d.NewValueKnown("application_id") {
+		return nil
+	}
+
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+
+	mappings, diags := readAllApplicationAttributeMappings(ctx, p1Client.APIClient, d.Get("environment_id").(string), d.Get("application_id").(string))
+	if diags.HasError() {
+		log.Printf("[WARN] Cannot read the attribute mappings of application %s, skipping the mapping name check: %s", d.Get("application_id").(string), diags[0].Summary)
+		return nil
+	}
+
+	for _, name := range names {
+		for _, mapping := range mappings {
+			if mapping.GetMappingType() != "CUSTOM" && name != mapping.GetName() && strings.EqualFold(name, mapping.GetName()) {
+				return fmt.Errorf("attribute mapping `%s` differs only by case from the existing mapping `%s`, use the existing name", name, mapping.GetName())
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceApplicationAttributeMappingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/appID\"", d.Id())
+	}
+
+	envID, appID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.Set("application_id", appID)
+	d.SetId(appID)
+
+	// Only custom mappings are imported; platform managed mappings are adopted when they are added to the configuration
+	resourceApplicationAttributeMappingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandApplicationAttributeMappings(d *schema.ResourceData) map[string]*pingone.ApplicationAttributeMapping {
+
+	mappings := make(map[string]*pingone.ApplicationAttributeMapping)
+
+	if v, ok := d.GetOk("mapping"); ok {
+		for _, mapping := range v.(*schema.Set).List() {
+			m := mapping.(map[string]interface{})
+			mappings[m["name"].(string)] = pingone.NewApplicationAttributeMapping(m["name"].(string), m["required"].(bool), m["value"].(string))
+		}
+	}
+
+	return mappings
+}
+
+func expandApplicationAttributeMappingsOriginal(d *schema.ResourceData) map[string]pingone.ApplicationAttributeMapping {
+
+	original := make(map[string]pingone.ApplicationAttributeMapping)
+
+	for _, v := range d.Get("original").([]interface{}) {
+		if v == nil {
+			continue
+		}
+
+		m := v.(map[string]interface{})
+		original[m["name"].(string)] = *pingone.NewApplicationAttributeMapping(m["name"].(string), m["required"].(bool), m["value"].(string))
+	}
+
+	return original
+}
+
+func flattenApplicationAttributeMappingsOriginal(in map[string]pingone.ApplicationAttributeMapping) []interface{} {
+
+	items := make([]interface{}, 0, len(in))
+
+	for _, v := range in {
+		items = append(items, map[string]interface{}{
+			"name":     v.GetName(),
+			"value":    v.GetValue(),
+			"required": v.GetRequired(),
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].(map[string]interface{})["name"].(string) < items[j].(map[string]interface{})["name"].(string)
+	})
+
+	return items
+}
@@ -555,20 +555,16 @@ func resourceApplicationOIDCImport(ctx context.Context, d *schema.ResourceData,
 
 func flattenApplicationAccessControl(in *pingone.ApplicationAccessControl) ([]interface{}, error) {
 
-	var flattenedApplicationAccessControlRole []interface{}
-	var flattenedApplicationAccessControlGroup []interface{}
+	var roleType string
+	flattenedApplicationAccessControlGroup := make([]interface{}, 0)
 
 	if v, ok := in.GetRoleOk(); ok {
 		if v1, ok := v.GetTypeOk(); ok {
-			flattenedApplicationAccessControlRole = append(flattenedApplicationAccessControlRole, map[string]interface{}{
-				"role_type": v1,
-			})
+			roleType = *v1
 		}
 	}
 
 	if v, ok := in.GetGroupOk(); ok {
-
-		var flattenedApplicationAccessControlGroupMap []interface{}
 		if v1, ok := v.GetTypeOk(); ok {
 
 			groupItems := make([]interface{}, 0, len(v.GetGroups()))
@@ -577,23 +573,18 @@ func flattenApplicationAccessControl(in *pingone.ApplicationAccessControl) ([]in
 				groupItems = append(groupItems, group.GetId())
 			}
 
-			flattenedApplicationAccessControlGroupMap = append(flattenedApplicationAccessControlGroupMap, map[string]interface{}{
+			flattenedApplicationAccessControlGroup = append(flattenedApplicationAccessControlGroup, map[string]interface{}{
 				"type":   v1,
 				"groups": groupItems,
 			})
 
 		}
-
-		flattenedApplicationAccessControlGroup = append(flattenedApplicationAccessControlGroup, map[string]interface{}{
-			"group": flattenedApplicationAccessControlGroupMap,
-		})
-
 	}
 
 	items := make([]interface{}, 0)
 	items = append(items, map[string]interface{}{
-		"role":  flattenedApplicationAccessControlRole,
-		"group": flattenedApplicationAccessControlGroup,
+		"role_type": roleType,
+		"group":     flattenedApplicationAccessControlGroup,
 	})
 
 	return items, nil
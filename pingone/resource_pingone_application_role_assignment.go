@@ -21,6 +21,8 @@ func resourceApplicationRoleAssignment() *schema.Resource {
 			StateContext: resourceApplicationRoleAssignmentImport,
 		},
 
+		CustomizeDiff: resourceRoleAssignmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
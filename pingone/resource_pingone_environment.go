@@ -22,6 +22,17 @@ func resourceEnvironment() *schema.Resource {
 			StateContext: resourceEnvironmentImport,
 		},
 
+		CustomizeDiff: resourceEnvironmentCustomizeDiff,
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Type:    resourceEnvironmentV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceEnvironmentStateUpgradeV0,
+				Version: 0,
+			},
+		},
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
@@ -41,17 +52,29 @@ func resourceEnvironment() *schema.Resource {
 				Default:      "SANDBOX",
 				ValidateFunc: validation.StringInSlice([]string{"PRODUCTION", "SANDBOX"}, false),
 			},
+			"force_replace_on_type_demotion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"region": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringInSlice([]string{"NA", "EU", "AP"}, false),
-				ForceNew:     true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice(regionCodes(), false),
+				DiffSuppressFunc: suppressEquivalentRegion,
+				ForceNew:         true,
 			},
 			"license_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"product": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -62,13 +85,22 @@ func resourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"default_population_name": {
-				Type:     schema.TypeString,
-				Required: true,
-			},
-			"default_population_description": {
-				Type:     schema.TypeString,
+			"default_population": {
+				Type:     schema.TypeSet,
 				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
 			},
 		},
 	}
@@ -116,7 +148,14 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 	envName := d.Get("name").(string)
 	envDescription := d.Get("description").(string)
 	envType := d.Get("type").(string)
-	envRegion := d.Get("region").(string)
+
+	// The region is sent in its API spelling, and falls back to the provider's region if it hasn't been set
+	envRegion := p1Client.region.APICode
+	if v, ok := d.GetOk("region"); ok {
+		if region, err := findRegion(v.(string)); err == nil {
+			envRegion = region.APICode
+		}
+	}
 
 	log.Printf("[INFO] Creating PingOne Environment: name %s, type %s", envName, envType)
 
@@ -157,28 +196,18 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	//Have to create a default population because of the destroy restriction on the population resource
-	popName := d.Get("default_population_name").(string)
-	popDescription := d.Get("default_population_description").(string)
+	d.SetId(resp.GetId())
 
-	log.Printf("[INFO] Creating PingOne Default Population: name %s", popName)
-
-	population := *pingone.NewPopulation(popName) // Population |  (optional)
-	population.SetDescription(popDescription)
-
-	popResp, popR, popErr := api_client.ManagementAPIsPopulationsApi.CreatePopulation(ctx, resp.GetId()).Population(population).Execute()
-	if (err != nil) || (r.StatusCode != 201) {
-		diags = append(diags, diag.Diagnostic{
-			Severity: diag.Error,
-			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.CreatePopulation``: %v", popErr),
-			Detail:   fmt.Sprintf("Full HTTP response: %v\n", popR.Body),
-		})
+	if v, ok := d.GetOk("default_population"); ok {
+		popID, popDiags := createEnvironmentDefaultPopulation(ctx, api_client, resp.GetId(), v.(*schema.Set).List()[0].(map[string]interface{}))
+		diags = append(diags, popDiags...)
+		if diags.HasError() {
+			return diags
+		}
 
-		return diags
+		d.Set("default_population_id", popID)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", resp.GetId(), popResp.GetId()))
-
 	return resourceEnvironmentRead(ctx, d, meta)
 }
 
@@ -190,8 +219,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	})
 	var diags diag.Diagnostics
 
-	attributes := strings.SplitN(d.Id(), "/", 2)
-	envID, populationID := attributes[0], attributes[1]
+	envID := d.Id()
 
 	resp, r, err := api_client.ManagementAPIsEnvironmentsApi.ReadOneEnvironment(ctx, envID).Execute()
 	if err != nil {
@@ -214,7 +242,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("name", resp.GetName())
 	d.Set("description", resp.GetDescription())
 	d.Set("type", resp.GetType())
-	d.Set("region", resp.GetRegion())
+	d.Set("region", flattenRegion(resp.GetRegion()))
 	d.Set("license_id", resp.GetLicense().Id)
 
 	respBOM, rBOM, errBOM := api_client.ManagementAPIsBillOfMaterialsBOMApi.ReadOneBillOfMaterials(ctx, envID).Execute()
@@ -232,14 +260,19 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	log.Printf("products: %v\n", productBOMItems)
 	d.Set("product", productBOMItems)
 
+	populationID := d.Get("default_population_id").(string)
+	if populationID == "" {
+		d.Set("default_population", nil)
+		return diags
+	}
+
 	popResp, popR, popErr := api_client.ManagementAPIsPopulationsApi.ReadOnePopulation(ctx, envID, populationID).Execute()
-	if err != nil {
+	if popErr != nil {
 
-		if r.StatusCode == 404 {
-			log.Printf("[INFO] PingOne Application Default Population no %s longer exists", populationID)
+		if popR.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Environment Default Population %s no longer exists", populationID)
 			d.Set("default_population_id", "")
-			d.Set("default_population_name", "")
-			d.Set("default_population_description", "")
+			d.Set("default_population", nil)
 			return diags
 		}
 
@@ -253,8 +286,12 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	d.Set("default_population_id", popResp.GetId())
-	d.Set("default_population_name", popResp.GetName())
-	d.Set("default_population_description", popResp.GetDescription())
+	d.Set("default_population", []interface{}{
+		map[string]interface{}{
+			"name":        popResp.GetName(),
+			"description": popResp.GetDescription(),
+		},
+	})
 
 	return diags
 }
@@ -267,12 +304,15 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 	})
 	var diags diag.Diagnostics
 
-	attributes := strings.SplitN(d.Id(), "/", 2)
-	envID, populationID := attributes[0], attributes[1]
+	envID := d.Id()
 	envName := d.Get("name").(string)
 	envDescription := d.Get("description").(string)
 	envType := d.Get("type").(string)
-	envRegion := d.Get("region").(string)
+
+	envRegion := p1Client.region.APICode
+	if region, err := findRegion(d.Get("region").(string)); err == nil {
+		envRegion = region.APICode
+	}
 
 	var environmentLicense pingone.EnvironmentLicense
 	if license, ok := d.GetOk("license_id"); ok {
@@ -283,7 +323,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 	environment.SetDescription(envDescription)
 
 	if change := d.HasChange("type"); change {
-		//If type has changed from SANDBOX -> PRODUCTION and vice versa we need a separate API call
+		//If type has changed from SANDBOX -> PRODUCTION we need a separate API call.  Demotion is handled as a replacement in the plan
 		inlineObject2 := *pingone.NewInlineObject2()
 		_, newType := d.GetChange("type")
 		inlineObject2.SetType(newType.(string))
@@ -292,7 +332,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsEnvironmentsApi.UpdateEnvironmentType``: %v", err),
-				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+				Detail:   fmt.Sprintf("Promoting an environment to PRODUCTION requires a license with the `environments.allowProduction` capability.\nFull HTTP response: %v\n", r.Body),
 			})
 
 			return diags
@@ -328,23 +368,43 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	if change := d.HasChange("default_population_name") || d.HasChange("default_population_description"); change {
+	if change := d.HasChange("default_population"); change {
 
-		popName := d.Get("default_population_name").(string)
-		popDescription := d.Get("default_population_description").(string)
+		populationID := d.Get("default_population_id").(string)
 
-		population := *pingone.NewPopulation(popName) // Population |  (optional)
-		population.SetDescription(popDescription)
+		if v, ok := d.GetOk("default_population"); ok {
 
-		_, r, err := api_client.ManagementAPIsPopulationsApi.UpdatePopulation(ctx, envID, populationID).Population(population).Execute()
-		if err != nil {
-			diags = append(diags, diag.Diagnostic{
-				Severity: diag.Error,
-				Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.UpdatePopulation``: %v", err),
-				Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
-			})
+			defaultPopulation := v.(*schema.Set).List()[0].(map[string]interface{})
 
-			return diags
+			if populationID == "" {
+				popID, popDiags := createEnvironmentDefaultPopulation(ctx, api_client, envID, defaultPopulation)
+				diags = append(diags, popDiags...)
+				if diags.HasError() {
+					return diags
+				}
+
+				d.Set("default_population_id", popID)
+
+			} else {
+				population := *pingone.NewPopulation(defaultPopulation["name"].(string)) // Population |  (optional)
+				population.SetDescription(defaultPopulation["description"].(string))
+
+				_, r, err := api_client.ManagementAPIsPopulationsApi.UpdatePopulation(ctx, envID, populationID).Population(population).Execute()
+				if err != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.UpdatePopulation``: %v", err),
+						Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+					})
+
+					return diags
+				}
+			}
+
+		} else {
+			// Removing the block stops the environment managing the population, the population itself is left in place
+			log.Printf("[INFO] PingOne Environment Default Population %s is no longer managed by the environment", populationID)
+			d.Set("default_population_id", "")
 		}
 
 	}
@@ -360,8 +420,17 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	})
 	var diags diag.Diagnostics
 
-	attributes := strings.SplitN(d.Id(), "/", 2)
-	envID := attributes[0]
+	envID := d.Id()
+
+	if d.Get("deletion_protection").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Environment %s cannot be destroyed as it has deletion protection enabled", envID),
+			Detail:   "Set `deletion_protection` to false and apply the change before destroying the environment.",
+		})
+
+		return diags
+	}
 
 	_, err := api_client.ManagementAPIsEnvironmentsApi.DeleteEnvironment(ctx, envID).Execute()
 	if err != nil {
@@ -379,20 +448,264 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 2)
 
-	if len(attributes) != 2 {
-		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/populationID\"", d.Id())
+	// The legacy "envID/populationID" format is still accepted so the default population is imported with the environment
+	if len(attributes) == 2 {
+		d.Set("default_population_id", attributes[1])
 	}
 
-	envID, populationID := attributes[0], attributes[1]
+	d.SetId(attributes[0])
 
-	d.Set("environment_id", envID)
-	d.SetId(fmt.Sprintf("%s/%s", envID, populationID))
+	resourceEnvironmentRead(ctx, d, meta)
 
-	resourceGroupRead(ctx, d, meta)
+	d.Set("deletion_protection", d.Get("type").(string) == "PRODUCTION")
 
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceEnvironmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	// Deletion protection defaults to on for production environments when it isn't set in config
+	if d.Id() == "" && !d.NewValueKnown("deletion_protection") {
+		if err := d.SetNew("deletion_protection", d.Get("type").(string) == "PRODUCTION"); err != nil {
+			return err
+		}
+	}
+
+	// Environments promoted to PRODUCTION are protected too, unless deletion protection is changed in the same plan
+	if d.Id() != "" && d.HasChange("type") && d.Get("type").(string) == "PRODUCTION" && !d.HasChange("deletion_protection") {
+		if err := d.SetNew("deletion_protection", true); err != nil {
+			return err
+		}
+	}
+
+	// The environment region defaults to the region the provider is configured for
+	if d.Id() == "" {
+		if v, ok := d.GetOk("region"); !ok || v.(string) == "" {
+			if err := d.SetNew("region", meta.(*p1Client).region.APICode); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Id() == "" || d.HasChange("license_id") || d.HasChange("type") || d.HasChange("product") {
+		if err := validateEnvironmentLicense(ctx, d, meta.(*p1Client)); err != nil {
+			return err
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	replaceKeys := []string{"region", "license_id"}
+
+	// PingOne only permits promotion from SANDBOX to PRODUCTION, a demotion can only be made by replacing the environment
+	if d.HasChange("type") {
+		oldType, newType := d.GetChange("type")
+
+		if oldType.(string) == "PRODUCTION" && newType.(string) == "SANDBOX" {
+			if !d.Get("force_replace_on_type_demotion").(bool) {
+				return fmt.Errorf("environment %s cannot be changed from PRODUCTION to SANDBOX in place.  Set `force_replace_on_type_demotion` to true to replace the environment instead", d.Id())
+			}
+
+			if err := d.ForceNew("type"); err != nil {
+				return err
+			}
+
+			replaceKeys = append(replaceKeys, "type")
+		}
+	}
+
+	deletionProtection, _ := d.GetChange("deletion_protection")
+	if !deletionProtection.(bool) {
+		return nil
+	}
+
+	for _, k := range replaceKeys {
+		oldValue, newValue := d.GetChange(k)
+		if k == "region" && suppressEquivalentRegion(k, oldValue.(string), newValue.(string), nil) {
+			continue
+		}
+
+		if d.HasChange(k) {
+			return fmt.Errorf("environment %s has deletion protection enabled and cannot be replaced due to a change in `%s`.  Set `deletion_protection` to false and apply the change before replacing the environment", d.Id(), k)
+		}
+	}
+
+	return nil
+}
+
+// Checks the environment type and products against the capabilities of the chosen license.  The check is skipped if the
+// license can't be read, for example where the worker app doesn't have permission to read the organization's licenses
+func validateEnvironmentLicense(ctx context.Context, d *schema.ResourceDiff, p1Client *p1Client) error {
+
+	if !d.NewValueKnown("license_id") || !d.NewValueKnown("product") {
+		return nil
+	}
+
+	licenseID := d.Get("license_id").(string)
+
+	orgID, err := p1Client.getOrganizationID(ctx)
+	if err != nil {
+		log.Printf("[WARN] Cannot determine the organization to validate license %s: %v", licenseID, err)
+		return nil
+	}
+
+	license, diags := readLicense(ctx, p1Client, orgID, licenseID)
+	if diags.HasError() {
+		log.Printf("[WARN] Cannot read license %s for validation: %s", licenseID, diags[0].Summary)
+		return nil
+	}
+
+	flattenedLicense := flattenLicense(license)
+
+	// The checks only run against properties that are present in the license, as flattenLicense defaults missing
+	// properties to their most restrictive values
+	if environments, ok := license["environments"].(map[string]interface{}); ok {
+		if allowProduction, ok := environments["allowProduction"].(bool); ok && !allowProduction && d.Get("type").(string) == "PRODUCTION" {
+			return fmt.Errorf("license %s (%s) does not permit PRODUCTION environments.  A license with the `environments.allowProduction` capability is required", licenseID, flattenedLicense["name"])
+		}
+	}
+
+	// Only PingOne services are licensed, other products are bookmarks to software hosted elsewhere.  Licenses that don't
+	// describe any product section aren't checked for products
+	gatedProducts := make(map[string]bool)
+	describesProducts := false
+	for section, v := range licenseProductSections {
+		gatedProducts[v] = true
+
+		if _, ok := license[section].(map[string]interface{}); ok {
+			describesProducts = true
+		}
+	}
+
+	if !describesProducts {
+		return nil
+	}
+
+	licensedProducts := make(map[string]bool)
+	for _, v := range flattenedLicense["products"].([]string) {
+		licensedProducts[v] = true
+	}
+
+	for _, v := range d.Get("product").(*schema.Set).List() {
+		productType := v.(map[string]interface{})["type"].(string)
+
+		if gatedProducts[productType] && !licensedProducts[productType] {
+			return fmt.Errorf("license %s (%s) does not include product %s", licenseID, flattenedLicense["name"], productType)
+		}
+	}
+
+	return nil
+}
+
+func createEnvironmentDefaultPopulation(ctx context.Context, api_client *pingone.APIClient, envID string, defaultPopulation map[string]interface{}) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	popName := defaultPopulation["name"].(string)
+	popDescription := defaultPopulation["description"].(string)
+
+	log.Printf("[INFO] Creating PingOne Default Population: name %s", popName)
+
+	population := *pingone.NewPopulation(popName) // Population |  (optional)
+	population.SetDescription(popDescription)
+
+	popResp, popR, popErr := api_client.ManagementAPIsPopulationsApi.CreatePopulation(ctx, envID).Population(population).Execute()
+	if (popErr != nil) || (popR.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.CreatePopulation``: %v", popErr),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", popR.Body),
+		})
+
+		return "", diags
+	}
+
+	return popResp.GetId(), diags
+}
+
+// Schema version 0 used a composite "envID/populationID" ID and flat default population attributes
+func resourceEnvironmentV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"license_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"product": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     billOfMaterialsProductElem,
+				Set:      HashByMapKey("type"),
+			},
+			"default_population_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_population_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"default_population_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+
+	if id, ok := rawState["id"].(string); ok {
+		attributes := strings.SplitN(id, "/", 2)
+
+		rawState["id"] = attributes[0]
+
+		if len(attributes) == 2 {
+			rawState["default_population_id"] = attributes[1]
+		}
+	}
+
+	defaultPopulation := map[string]interface{}{
+		"name":        rawState["default_population_name"],
+		"description": rawState["default_population_description"],
+	}
+
+	if defaultPopulation["description"] == nil {
+		defaultPopulation["description"] = ""
+	}
+
+	rawState["default_population"] = []interface{}{defaultPopulation}
+
+	// Deletion protection is new in this version and takes the same default as a new environment
+	rawState["deletion_protection"] = rawState["type"] == "PRODUCTION"
+
+	delete(rawState, "default_population_name")
+	delete(rawState, "default_population_description")
+
+	return rawState, nil
+}
+
 func buildBOMProductsCreateRequest(items []interface{}) []pingone.BillOfMaterialsProducts {
 	var productBOMItems []pingone.BillOfMaterialsProducts
 
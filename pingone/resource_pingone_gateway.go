@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/patrickcping/pingone-go"
@@ -23,6 +25,13 @@ func resourceGateway() *schema.Resource {
 			StateContext: resourceGatewayImport,
 		},
 
+		CustomizeDiff: resourceGatewayCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
@@ -47,10 +56,148 @@ func resourceGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"wait_for_instances": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"bind_dn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bind_password": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"connection_security": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"None", "TLS", "StartTLS"}, false),
+			},
+			"vendor": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PingDirectory", "Microsoft Active Directory", "Oracle Directory Server Enterprise Edition", "Oracle Unified Directory", "CA Directory", "OpenDJ Directory", "IBM (Tivoli) Security Directory Server", "LDAP v3 compliant Directory Server"}, false),
+			},
+			"servers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"validate_tls_certificates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"kerberos": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service_account_upn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_account_password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"retain_previous_credentials_mins": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"user_type": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password_authority": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"PING_ONE", "LDAP"}, false),
+						},
+						"search_base_dn": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ordered_correlation_attributes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"allow_password_changes": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"push_password_changes_to_ldap": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"new_user_lookup": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ldap_filter_pattern": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"population_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"attribute_mapping": {
+										Type:     schema.TypeSet,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// Attributes that only apply to LDAP gateways
+var gatewayLDAPKeys = []string{"bind_dn", "bind_password", "connection_security", "vendor", "servers", "validate_tls_certificates", "kerberos", "user_type"}
+
 func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	p1Client := meta.(*p1Client)
 	api_client := p1Client.APIClient
@@ -72,7 +219,7 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 		return diags
 	}
 
-	log.Printf("[INFO] Creating PingOne Gateway: name %s", gateway.GetName())
+	log.Printf("[INFO] Creating PingOne Gateway: name %s", d.Get("name").(string))
 
 	resp, r, err := api_client.ManagementAPIsGatewayManagementGatewaysApi.CreateGateway(ctx, envID).OneOfGatewayGatewayLDAP(gateway).Execute()
 	if (err != nil) || (r.StatusCode != 201) {
@@ -87,7 +234,21 @@ func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(resp.(map[string]interface{})["id"].(string))
 
-	return resourceGatewayRead(ctx, d, meta)
+	// An error after the ID is set would taint the new gateway, so a failed wait is reported as a warning.  The wait isn't
+	// recorded in state, so it is planned again as an update
+	if v := d.Get("wait_for_instances").(int); v > 0 {
+		waitDiags := waitForGatewayInstances(ctx, api_client, envID, d.Id(), v, d.Timeout(schema.TimeoutCreate))
+		if waitDiags.HasError() {
+			for i := range waitDiags {
+				waitDiags[i].Severity = diag.Warning
+			}
+
+			d.Set("wait_for_instances", 0)
+		}
+		diags = append(diags, waitDiags...)
+	}
+
+	return append(diags, resourceGatewayRead(ctx, d, meta)...)
 }
 
 func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -130,13 +291,38 @@ func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	gateway := pingone.Gateway{}
-	json.Unmarshal([]byte(b), &gateway)
+	if err := json.Unmarshal([]byte(b), &gateway); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal gateway json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
 
 	d.Set("name", gateway.GetName())
 	d.Set("description", gateway.GetDescription())
 	d.Set("type", gateway.GetType())
 	d.Set("enabled", gateway.GetEnabled())
 
+	if gateway.GetType() == "LDAP" {
+		gatewayLDAP := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(b), &gatewayLDAP); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cannot unmarshal LDAP gateway json response",
+				Detail:   fmt.Sprintf("Full error: %v\n", err),
+			})
+
+			return diags
+		}
+
+		for k, v := range flattenGatewayLDAP(gatewayLDAP, d) {
+			d.Set(k, v)
+		}
+	}
+
 	return diags
 }
 
@@ -162,7 +348,7 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return diags
 	}
 
-	log.Printf("[INFO] Updating PingOne Gateway: name %s", gateway.GetName())
+	log.Printf("[INFO] Updating PingOne Gateway: name %s", d.Get("name").(string))
 
 	_, r, err := api_client.ManagementAPIsGatewayManagementGatewaysApi.UpdateGateway(ctx, envID, gatewayID).OneOfGatewayGatewayLDAP(gateway).Execute()
 	if err != nil {
@@ -175,6 +361,17 @@ func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return diags
 	}
 
+	if v := d.Get("wait_for_instances").(int); v > 0 && d.HasChange("wait_for_instances") {
+		diags = append(diags, waitForGatewayInstances(ctx, api_client, envID, gatewayID, v, d.Timeout(schema.TimeoutUpdate))...)
+		if diags.HasError() {
+			// The previous value is kept so that the wait is planned again
+			old, _ := d.GetChange("wait_for_instances")
+			d.Set("wait_for_instances", old)
+
+			return diags
+		}
+	}
+
 	return resourceGatewayRead(ctx, d, meta)
 }
 
@@ -220,7 +417,97 @@ func resourceGatewayImport(ctx context.Context, d *schema.ResourceData, meta int
 	return []*schema.ResourceData{d}, nil
 }
 
-func expandGateway(d *schema.ResourceData) (pingone.Gateway, error) {
+// Instances can only connect once a gateway credential has been created for the gateway, so when the credential is managed
+// in the same configuration the wait is normally applied as an update, after the gateway container has been deployed
+func waitForGatewayInstances(ctx context.Context, api_client *pingone.APIClient, envID, gatewayID string, count int, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		instances, listDiags := readGatewayInstances(ctx, api_client, envID, gatewayID)
+		if listDiags.HasError() {
+			return resource.NonRetryableError(fmt.Errorf("%s: %s", listDiags[0].Summary, listDiags[0].Detail))
+		}
+
+		connected := 0
+		for _, instance := range instances {
+			if instance.GetConnected() {
+				connected++
+			}
+		}
+
+		log.Printf("[DEBUG] Gateway %s has %d of %d connected instances", gatewayID, connected, count)
+
+		if connected < count {
+			return resource.RetryableError(fmt.Errorf("%d of the %d instances set in `wait_for_instances` are connected", connected, count))
+		}
+
+		return nil
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when waiting for gateway %s instances to connect", gatewayID),
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	return diags
+}
+
+func resourceGatewayCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	gatewayType := d.Get("type").(string)
+
+	if gatewayType != "LDAP" {
+		for _, k := range gatewayLDAPKeys {
+			if k == "validate_tls_certificates" {
+				if v, ok := d.GetOkExists(k); ok && d.NewValueKnown(k) && v.(bool) {
+					return fmt.Errorf("`%s` can only be set on LDAP gateways, the gateway type is %s", k, gatewayType)
+				}
+				continue
+			}
+
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf("`%s` can only be set on LDAP gateways, the gateway type is %s", k, gatewayType)
+			}
+		}
+
+		return nil
+	}
+
+	// Only enforced when the LDAP configuration is being set, so that existing gateways created without these values
+	// can still be planned
+	ldapChanged := d.Id() == "" || d.HasChange("type")
+	for _, k := range gatewayLDAPKeys {
+		if d.HasChange(k) {
+			ldapChanged = true
+		}
+	}
+
+	if !ldapChanged {
+		return nil
+	}
+
+	for _, k := range []string{"bind_dn", "bind_password", "vendor"} {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+
+		if _, ok := d.GetOk(k); !ok {
+			return fmt.Errorf("`%s` is required for LDAP gateways", k)
+		}
+	}
+
+	return nil
+}
+
+func expandGateway(d *schema.ResourceData) (interface{}, error) {
+
+	if d.Get("type").(string) == "LDAP" {
+		return expandGatewayLDAP(d)
+	}
 
 	gateway := *pingone.NewGateway(d.Get("name").(string), d.Get("type").(string), d.Get("enabled").(bool))
 	if v, ok := d.GetOk("description"); ok {
@@ -229,3 +516,166 @@ func expandGateway(d *schema.ResourceData) (pingone.Gateway, error) {
 
 	return gateway, nil
 }
+
+// The SDK's GatewayLDAP model doesn't include kerberos or the user type name, password authority, search base and
+// correlation attributes, so the LDAP gateway body is built as a map.  The create and update functions take an untyped body
+func expandGatewayLDAP(d *schema.ResourceData) (map[string]interface{}, error) {
+
+	gateway := map[string]interface{}{
+		"name":         d.Get("name").(string),
+		"type":         d.Get("type").(string),
+		"enabled":      d.Get("enabled").(bool),
+		"bindDN":       d.Get("bind_dn").(string),
+		"bindPassword": d.Get("bind_password").(string),
+		"vendor":       d.Get("vendor").(string),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		gateway["description"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("connection_security"); ok {
+		gateway["connectionSecurity"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("servers"); ok {
+		gateway["serversHostAndPort"] = marshalInterfaceToString(v.(*schema.Set).List())
+	}
+
+	if v, ok := d.GetOkExists("validate_tls_certificates"); ok {
+		gateway["validateTlsCertificates"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("kerberos"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		kerberosConfig := v.([]interface{})[0].(map[string]interface{})
+
+		kerberos := map[string]interface{}{
+			"serviceAccountUserPrincipalName": kerberosConfig["service_account_upn"].(string),
+		}
+
+		if v1, ok := kerberosConfig["service_account_password"].(string); ok && v1 != "" {
+			kerberos["serviceAccountPassword"] = v1
+		}
+
+		if v1, ok := kerberosConfig["retain_previous_credentials_mins"].(int); ok && v1 > 0 {
+			kerberos["retainPreviousCredentialsMins"] = v1
+		}
+
+		gateway["kerberos"] = kerberos
+	}
+
+	userTypes := make([]interface{}, 0)
+	for _, v := range d.Get("user_type").([]interface{}) {
+		userTypeConfig := v.(map[string]interface{})
+
+		userType := map[string]interface{}{
+			"name":                         userTypeConfig["name"].(string),
+			"passwordAuthority":            userTypeConfig["password_authority"].(string),
+			"searchBaseDn":                 userTypeConfig["search_base_dn"].(string),
+			"orderedCorrelationAttributes": marshalInterfaceToString(userTypeConfig["ordered_correlation_attributes"].([]interface{})),
+			"allowPasswordChanges":         userTypeConfig["allow_password_changes"].(bool),
+			"pushPasswordChangesToLdap":    userTypeConfig["push_password_changes_to_ldap"].(bool),
+		}
+
+		if v1, ok := userTypeConfig["id"].(string); ok && v1 != "" {
+			userType["id"] = v1
+		}
+
+		if v1, ok := userTypeConfig["new_user_lookup"].([]interface{}); ok && len(v1) > 0 && v1[0] != nil {
+			newUserLookupConfig := v1[0].(map[string]interface{})
+
+			attributeMappings := make([]interface{}, 0)
+			for _, v2 := range newUserLookupConfig["attribute_mapping"].(*schema.Set).List() {
+				attributeMapping := v2.(map[string]interface{})
+
+				attributeMappings = append(attributeMappings, map[string]interface{}{
+					"name":  attributeMapping["name"].(string),
+					"value": attributeMapping["value"].(string),
+				})
+			}
+
+			userType["newUserLookup"] = map[string]interface{}{
+				"ldapFilterPattern": newUserLookupConfig["ldap_filter_pattern"].(string),
+				"population": map[string]interface{}{
+					"id": newUserLookupConfig["population_id"].(string),
+				},
+				"attributeMappings": attributeMappings,
+			}
+		}
+
+		userTypes = append(userTypes, userType)
+	}
+	gateway["userTypes"] = userTypes
+
+	return gateway, nil
+}
+
+// Passwords aren't returned by the API, so they are carried over from state
+func flattenGatewayLDAP(in map[string]interface{}, d *schema.ResourceData) map[string]interface{} {
+
+	item := map[string]interface{}{
+		"bind_dn":                   in["bindDN"],
+		"connection_security":       in["connectionSecurity"],
+		"vendor":                    in["vendor"],
+		"servers":                   in["serversHostAndPort"],
+		"validate_tls_certificates": in["validateTlsCertificates"],
+	}
+
+	kerberos := make([]interface{}, 0)
+	if v, ok := in["kerberos"].(map[string]interface{}); ok {
+		kerberos = append(kerberos, map[string]interface{}{
+			"service_account_upn":              v["serviceAccountUserPrincipalName"],
+			"service_account_password":         d.Get("kerberos.0.service_account_password").(string),
+			"retain_previous_credentials_mins": v["retainPreviousCredentialsMins"],
+		})
+	}
+	item["kerberos"] = kerberos
+
+	userTypes := make([]interface{}, 0)
+	if v, ok := in["userTypes"].([]interface{}); ok {
+		for _, v1 := range v {
+			userType := v1.(map[string]interface{})
+
+			newUserLookup := make([]interface{}, 0)
+			if v2, ok := userType["newUserLookup"].(map[string]interface{}); ok {
+
+				attributeMappings := make([]interface{}, 0)
+				if v3, ok := v2["attributeMappings"].([]interface{}); ok {
+					for _, v4 := range v3 {
+						attributeMapping := v4.(map[string]interface{})
+
+						attributeMappings = append(attributeMappings, map[string]interface{}{
+							"name":  attributeMapping["name"],
+							"value": attributeMapping["value"],
+						})
+					}
+				}
+
+				var populationID interface{}
+				if v3, ok := v2["population"].(map[string]interface{}); ok {
+					populationID = v3["id"]
+				}
+
+				newUserLookup = append(newUserLookup, map[string]interface{}{
+					"ldap_filter_pattern": v2["ldapFilterPattern"],
+					"population_id":       populationID,
+					"attribute_mapping":   attributeMappings,
+				})
+			}
+
+			userTypes = append(userTypes, map[string]interface{}{
+				"id":                             userType["id"],
+				"name":                           userType["name"],
+				"password_authority":             userType["passwordAuthority"],
+				"search_base_dn":                 userType["searchBaseDn"],
+				"ordered_correlation_attributes": userType["orderedCorrelationAttributes"],
+				"allow_password_changes":         userType["allowPasswordChanges"],
+				"push_password_changes_to_ldap":  userType["pushPasswordChangesToLdap"],
+				"new_user_lookup":                newUserLookup,
+			})
+		}
+	}
+	item["user_type"] = userTypes
+
+	return item
+}
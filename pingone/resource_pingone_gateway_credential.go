@@ -2,11 +2,15 @@ package pingone
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/patrickcping/pingone-go"
 )
@@ -31,6 +35,14 @@ func resourceGatewayCredential() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"credential": {
 				Type:      schema.TypeString,
 				Computed:  true,
@@ -48,6 +60,14 @@ func resourceGatewayCredential() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_used_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -81,24 +101,78 @@ func resourceGatewayCredentialCreate(ctx context.Context, d *schema.ResourceData
 	d.Set("console_url", resp.GetConsoleUrl())
 	d.Set("api_url", resp.GetApiUrl())
 	d.Set("auth_url", resp.GetAuthUrl())
+	d.Set("created_at", resp.GetCreatedAt())
+	d.Set("last_used_at", resp.GetLastUsedAt())
 
-	return resourceGatewayCredentialRead(ctx, d, meta)
+	// The credential is set from the create response rather than read back, as the new credential may not yet be listed
+	// on the gateway object
+	return diags
 }
 
 func resourceGatewayCredentialRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
 	var diags diag.Diagnostics
 
-	/// Do something here for sure
-	/*
+	envID := d.Get("environment_id").(string)
+	gatewayID := d.Get("gateway_id").(string)
 
+	// A new credential may not yet be listed on the gateway object, so a missing credential is read again for a short time
+	// before it is removed from state
+	var credential *pingone.GatewayCredential
+	var r *http.Response
+	notListed := false
+
+	err := resource.RetryContext(ctx, 30*time.Second, func() *resource.RetryError {
+		credentials, rList, err := readGatewayCredentials(ctx, api_client, envID, gatewayID)
+		r = rList
+		notListed = false
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
 
-		if r.StatusCode == 404 {
-			log.Printf("[INFO] PingOne Application Resource Grant %s no longer exists", d.Id())
+		for i := range credentials {
+			if credentials[i].GetId() == d.Id() {
+				credential = &credentials[i]
+				return nil
+			}
+		}
+
+		notListed = true
+		return resource.RetryableError(fmt.Errorf("gateway credential %s is not listed on gateway %s", d.Id(), gatewayID))
+	})
+	if err != nil && notListed {
+		log.Printf("[INFO] PingOne Gateway Credential %s no longer exists", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+
+		if r != nil && r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Gateway %s no longer exists", gatewayID)
 			d.SetId("")
 			return nil
 		}
-	*/
+
+		detail := fmt.Sprintf("Full error: %v\n", err)
+		if r != nil {
+			detail = fmt.Sprintf("Full HTTP response: %v\n", r.Body)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsGatewayManagementGatewaysApi.ReadOneGateway``: %v", err),
+			Detail:   detail,
+		})
+
+		return diags
+	}
+
+	d.Set("created_at", credential.GetCreatedAt())
+	d.Set("last_used_at", credential.GetLastUsedAt())
 
 	return diags
 }
@@ -130,9 +204,9 @@ func resourceGatewayCredentialDelete(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceGatewayCredentialImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	attributes := strings.SplitN(d.Id(), "/", 2)
+	attributes := strings.SplitN(d.Id(), "/", 3)
 
-	if len(attributes) != 2 {
+	if len(attributes) != 3 {
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/gatewayID/gatewayCredentialID\"", d.Id())
 	}
 
@@ -146,3 +220,24 @@ func resourceGatewayCredentialImport(ctx context.Context, d *schema.ResourceData
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// There is no endpoint to read gateway credentials, but the credentials are returned in the gateway object
+func readGatewayCredentials(ctx context.Context, api_client *pingone.APIClient, envID, gatewayID string) ([]pingone.GatewayCredential, *http.Response, error) {
+
+	resp, r, err := api_client.ManagementAPIsGatewayManagementGatewaysApi.ReadOneGateway(ctx, envID, gatewayID).Execute()
+	if err != nil {
+		return nil, r, err
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return nil, r, err
+	}
+
+	gateway := pingone.Gateway{}
+	if err := json.Unmarshal(b, &gateway); err != nil {
+		return nil, r, err
+	}
+
+	return gateway.GetCredentials(), r, nil
+}
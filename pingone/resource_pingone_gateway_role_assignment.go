@@ -21,6 +21,8 @@ func resourceGatewayRoleAssignment() *schema.Resource {
 			StateContext: resourceGatewayRoleAssignmentImport,
 		},
 
+		CustomizeDiff: resourceRoleAssignmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
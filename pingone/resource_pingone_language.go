@@ -0,0 +1,186 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceLanguage() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLanguageCreate,
+		ReadContext:   resourceLanguageRead,
+		DeleteContext: resourceLanguageDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLanguageImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"customer_added": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLanguageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	locale := d.Get("locale").(string)
+
+	log.Printf("[INFO] Creating PingOne Language: locale %s", locale)
+
+	language := map[string]interface{}{
+		"locale": locale,
+	}
+
+	r, err := api_client.ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesPost(ctx, envID).Body(language).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal language json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceLanguageRead(ctx, d, meta)
+}
+
+func resourceLanguageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	languageID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	r, err := api_client.ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDGet(ctx, envID, languageID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Language %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal language json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("locale", resp["locale"])
+	d.Set("name", resp["name"])
+	d.Set("enabled", resp["enabled"])
+	d.Set("default", resp["default"])
+	d.Set("customer_added", resp["customerAdded"])
+
+	return diags
+}
+
+func resourceLanguageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	languageID := d.Id()
+
+	_, err := api_client.ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDDelete(ctx, envID, languageID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDDelete``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceLanguageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/languageID\"", d.Id())
+	}
+
+	envID, languageID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.SetId(languageID)
+
+	resourceLanguageRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,189 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceLanguageUpdate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLanguageUpdateCreate,
+		ReadContext:   resourceLanguageUpdateRead,
+		UpdateContext: resourceLanguageUpdateUpdate,
+		DeleteContext: resourceLanguageUpdateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceLanguageUpdateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"language_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"locale": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLanguageUpdateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+
+	// The language already exists in the environment, so creating this resource is just an update of its status
+	d.SetId(d.Get("language_id").(string))
+
+	return resourceLanguageUpdateUpdate(ctx, d, meta)
+}
+
+func resourceLanguageUpdateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	languageID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	r, err := api_client.ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDGet(ctx, envID, languageID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Language %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal language json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("language_id", resp["id"])
+	d.Set("locale", resp["locale"])
+	d.Set("enabled", resp["enabled"])
+	d.Set("default", resp["default"])
+
+	return diags
+}
+
+func resourceLanguageUpdateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	languageID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	language := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+		"default": d.Get("default").(bool),
+	}
+
+	log.Printf("[INFO] Updating PingOne Language: language %s", languageID)
+
+	r, err := api_client.ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDPut(ctx, envID, languageID).Body(language).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDPut``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	return resourceLanguageUpdateRead(ctx, d, meta)
+}
+
+func resourceLanguageUpdateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	languageID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	// The default language can't be disabled, it has to be replaced as default by another language first
+	if d.Get("default").(bool) {
+		log.Printf("[INFO] PingOne Language %s is the environment default and will be left enabled", languageID)
+		return nil
+	}
+
+	language := map[string]interface{}{
+		"enabled": false,
+	}
+
+	_, err := api_client.ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDPut(ctx, envID, languageID).Body(language).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsLanguageManagementLanguagesApi.V1EnvironmentsEnvIDLanguagesLanguageIDPut``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceLanguageUpdateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/languageID\"", d.Id())
+	}
+
+	envID, languageID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.Set("language_id", languageID)
+	d.SetId(languageID)
+
+	resourceLanguageUpdateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/patrickcping/pingone-go"
 )
@@ -21,6 +24,10 @@ func resourcePopulation() *schema.Resource {
 			StateContext: resourcePopulationImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
@@ -35,6 +42,27 @@ func resourcePopulation() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"delete_users_on_destroy": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"prevent_destroy_if_not_empty"},
+			},
+			"prevent_destroy_if_not_empty": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       true,
+				ConflictsWith: []string{"delete_users_on_destroy"},
+			},
+			"user_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -49,14 +77,10 @@ func resourcePopulationCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	envID := d.Get("environment_id").(string)
 	popName := d.Get("name").(string)
-	popDescription := d.Get("description").(string)
 
 	log.Printf("[INFO] Creating PingOne Population: name %s", popName)
 
-	population := *pingone.NewPopulation(popName) // Population |  (optional)
-	population.SetDescription(popDescription)
-
-	resp, r, err := api_client.ManagementAPIsPopulationsApi.CreatePopulation(ctx, envID).Population(population).Execute()
+	r, err := executeRawRequest(ctx, api_client, http.MethodPost, "ManagementAPIsPopulationsApiService.CreatePopulation", fmt.Sprintf("/v1/environments/%s/populations", envID), expandPopulation(d))
 	if (err != nil) || (r.StatusCode != 201) {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -67,7 +91,18 @@ func resourcePopulationCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diags
 	}
 
-	d.SetId(resp.GetId())
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal population json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
 
 	return resourcePopulationRead(ctx, d, meta)
 }
@@ -100,8 +135,21 @@ func resourcePopulationRead(ctx context.Context, d *schema.ResourceData, meta in
 		return diags
 	}
 
+	body, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal population json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
 	d.Set("name", resp.GetName())
 	d.Set("description", resp.GetDescription())
+	d.Set("user_count", resp.GetUserCount())
+	d.Set("default", populationDefaults([]interface{}{body})[resp.GetId()])
 
 	return diags
 }
@@ -117,13 +165,8 @@ func resourcePopulationUpdate(ctx context.Context, d *schema.ResourceData, meta
 	envID := d.Get("environment_id").(string)
 
 	popID := d.Id()
-	popName := d.Get("name").(string)
-	popDescription := d.Get("description").(string)
-
-	population := *pingone.NewPopulation(popName) // Population |  (optional)
-	population.SetDescription(popDescription)
 
-	_, r, err := api_client.ManagementAPIsPopulationsApi.UpdatePopulation(ctx, envID, popID).Population(population).Execute()
+	r, err := executeRawRequest(ctx, api_client, http.MethodPut, "ManagementAPIsPopulationsApiService.UpdatePopulation", fmt.Sprintf("/v1/environments/%s/populations/%s", envID, popID), expandPopulation(d))
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -149,7 +192,74 @@ func resourcePopulationDelete(ctx context.Context, d *schema.ResourceData, meta
 
 	popID := d.Id()
 
-	_, err := api_client.ManagementAPIsPopulationsApi.DeletePopulation(ctx, envID, popID).Execute()
+	resp, r, err := api_client.ManagementAPIsPopulationsApi.ReadOnePopulation(ctx, envID, popID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Population %s no longer exists", popID)
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsPopulationsApi.ReadOnePopulation``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	body, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal population json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	// The default population is owned by the environment and is removed when the environment is destroyed
+	if populationDefaults([]interface{}{body})[popID] {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Population %s is the environment default population and has been removed from state but not deleted from the platform", popID),
+			Detail:   "The default population is deleted when the environment is destroyed.",
+		})
+
+		return diags
+	}
+
+	if userCount := resp.GetUserCount(); userCount > 0 {
+
+		if d.Get("delete_users_on_destroy").(bool) {
+			diags = append(diags, deletePopulationUsers(ctx, api_client, envID, popID, d.Timeout(schema.TimeoutDelete))...)
+			if diags.HasError() {
+				return diags
+			}
+
+		} else if d.Get("prevent_destroy_if_not_empty").(bool) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Population %s cannot be destroyed as it contains %d user(s)", popID, userCount),
+				Detail:   "Move or delete the users in the population before destroying it, or set `delete_users_on_destroy` to delete them as part of the destroy.",
+			})
+
+			return diags
+
+		} else {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Population %s contains %d user(s) and has been removed from state but not deleted from the platform", popID, userCount),
+			})
+
+			d.SetId("")
+
+			return diags
+		}
+	}
+
+	_, err = api_client.ManagementAPIsPopulationsApi.DeletePopulation(ctx, envID, popID).Execute()
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -159,7 +269,7 @@ func resourcePopulationDelete(ctx context.Context, d *schema.ResourceData, meta
 		return diags
 	}
 
-	return nil
+	return diags
 }
 
 func resourcePopulationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -178,3 +288,90 @@ func resourcePopulationImport(ctx context.Context, d *schema.ResourceData, meta
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// The SDK population model doesn't include the `default` flag, so population requests are sent with an untyped body.
+// The flag is only sent when set, as the platform moves the default to the flagged population rather than unsetting it
+func expandPopulation(d *schema.ResourceData) map[string]interface{} {
+
+	population := map[string]interface{}{
+		"name": d.Get("name").(string),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		population["description"] = v.(string)
+	}
+
+	if d.Get("default").(bool) {
+		population["default"] = true
+	}
+
+	return population
+}
+
+// Users are deleted a page at a time until the population's user count reaches zero.  The user list is eventually
+// consistent, so users that have already been deleted are skipped, and the population is read again until the count
+// catches up or the delete timeout is reached
+func deletePopulationUsers(ctx context.Context, api_client *pingone.APIClient, envID, popID string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	filter := fmt.Sprintf("population.id eq \"%s\"", popID)
+	limit := int32(100)
+
+	deleted := make(map[string]bool)
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+
+		resp, r, err := api_client.ManagementAPIsUsersUsersApi.ReadAllUsers(ctx, envID).Filter(filter).Limit(limit).Execute()
+		if err != nil {
+			if r != nil {
+				return resource.NonRetryableError(fmt.Errorf("error when calling `ManagementAPIsUsersUsersApi.ReadAllUsers`: %v\nFull HTTP response: %v", err, r.Body))
+			}
+			return resource.NonRetryableError(fmt.Errorf("error when calling `ManagementAPIsUsersUsersApi.ReadAllUsers`: %v", err))
+		}
+
+		if users := resp.GetEmbedded().Users; users != nil {
+			for _, user := range *users {
+
+				if deleted[user.GetId()] {
+					continue
+				}
+
+				log.Printf("[INFO] Deleting PingOne User %s from population %s", user.GetId(), popID)
+
+				r, err := api_client.ManagementAPIsUsersUsersApi.DeleteUser(ctx, envID, user.GetId()).Execute()
+				if err != nil && (r == nil || r.StatusCode != 404) {
+					return resource.NonRetryableError(fmt.Errorf("error when calling `ManagementAPIsUsersUsersApi.DeleteUser`: %v", err))
+				}
+
+				deleted[user.GetId()] = true
+			}
+		}
+
+		population, r, err := api_client.ManagementAPIsPopulationsApi.ReadOnePopulation(ctx, envID, popID).Execute()
+		if err != nil {
+			if r != nil {
+				return resource.NonRetryableError(fmt.Errorf("error when calling `ManagementAPIsPopulationsApi.ReadOnePopulation`: %v\nFull HTTP response: %v", err, r.Body))
+			}
+			return resource.NonRetryableError(fmt.Errorf("error when calling `ManagementAPIsPopulationsApi.ReadOnePopulation`: %v", err))
+		}
+
+		if userCount := population.GetUserCount(); userCount > 0 {
+			return resource.RetryableError(fmt.Errorf("%d user(s) remain in population %s", userCount, popID))
+		}
+
+		return nil
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Population %s cannot be destroyed as users remain in it after deleting %d user(s)", popID, len(deleted)),
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	log.Printf("[INFO] Deleted %d user(s) from population %s", len(deleted), popID)
+
+	return diags
+}
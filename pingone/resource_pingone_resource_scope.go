@@ -43,7 +43,8 @@ func resourceResourceScope() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateAttributePath,
 				},
 			},
 		},
@@ -219,7 +220,7 @@ func expandResourceScope(d *schema.ResourceData) (pingone.ResourceScope, error)
 	}
 
 	if v, ok := d.GetOk("schema_attributes"); ok {
-		resource.SetSchemaAttributes(v.([]string))
+		resource.SetSchemaAttributes(marshalInterfaceToString(v.([]interface{})))
 	}
 
 	return resource, nil
@@ -2,10 +2,15 @@ package pingone
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"regexp"
+	"regexp/syntax"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -22,6 +27,8 @@ func resourceSchemaAttribute() *schema.Resource {
 			StateContext: resourceSchemaAttributeImport,
 		},
 
+		CustomizeDiff: resourceSchemaAttributeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
@@ -82,6 +89,87 @@ func resourceSchemaAttribute() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sub_attribute": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      HashByMapKey("name"),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "STRING",
+							ValidateFunc: validation.StringInSlice([]string{"STRING", "JSON", "BOOLEAN"}, false),
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"multivalued": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"enumerated_values": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"regex_validation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateSchemaAttributeRegExp,
+						},
+						"requirements": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"values_pattern_should_match": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"values_pattern_should_not_match": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -96,25 +184,21 @@ func resourceSchemaAttributeCreate(ctx context.Context, d *schema.ResourceData,
 
 	envID := d.Get("environment_id").(string)
 	schemaID := d.Get("schema_id").(string)
-	attributeName := d.Get("name").(string)
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	enabled := d.Get("enabled").(bool)
-	attributeType := d.Get("type").(string)
-	unique := d.Get("unique").(bool)
-	multivalued := d.Get("multivalued").(bool)
-	required := d.Get("required").(bool)
 
-	log.Printf("[INFO] Creating PingOne Schema Attribute: name %s", attributeName)
+	schemaAttribute, err := expandSchemaAttribute(d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot expand Schema Attribute into SDK object",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
 
-	schemaAttribute := *pingone.NewSchemaAttribute(enabled, attributeName, attributeType) // SchemaAttribute |  (optional)
-	schemaAttribute.SetDisplayName(displayName)
-	schemaAttribute.SetDescription(description)
-	schemaAttribute.SetUnique(unique)
-	schemaAttribute.SetMultiValued(multivalued)
-	schemaAttribute.SetRequired(required)
+		return diags
+	}
 
-	resp, r, err := api_client.ManagementAPIsSchemasApi.CreateAttribute(ctx, envID, schemaID).SchemaAttribute(schemaAttribute).Execute()
+	log.Printf("[INFO] Creating PingOne Schema Attribute: name %s", schemaAttribute["name"])
+
+	r, err := executeRawRequest(ctx, api_client, http.MethodPost, "ManagementAPIsSchemasApiService.CreateAttribute", fmt.Sprintf("/v1/environments/%s/schemas/%s/attributes", envID, schemaID), schemaAttribute)
 	if (err != nil) || (r.StatusCode != 201) {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -125,7 +209,18 @@ func resourceSchemaAttributeCreate(ctx context.Context, d *schema.ResourceData,
 		return diags
 	}
 
-	d.SetId(resp.GetId())
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal schema attribute json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
 
 	return resourceSchemaAttributeRead(ctx, d, meta)
 }
@@ -170,6 +265,21 @@ func resourceSchemaAttributeRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("required", resp.GetRequired())
 	d.Set("schema_type", resp.GetSchemaType())
 
+	body, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal schema attribute json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("sub_attribute", flattenSchemaAttributeSubAttributes(body))
+	d.Set("enumerated_values", flattenSchemaAttributeEnumeratedValues(body))
+	d.Set("regex_validation", flattenSchemaAttributeRegexValidation(body))
+
 	return diags
 }
 
@@ -185,29 +295,27 @@ func resourceSchemaAttributeUpdate(ctx context.Context, d *schema.ResourceData,
 
 	envID := d.Get("environment_id").(string)
 	schemaID := d.Get("schema_id").(string)
-	attributeName := d.Get("name").(string)
-	displayName := d.Get("display_name").(string)
-	description := d.Get("description").(string)
-	enabled := d.Get("enabled").(bool)
-	attributeType := d.Get("type").(string)
-	unique := d.Get("unique").(bool)
-	multivalued := d.Get("multivalued").(bool)
-	required := d.Get("required").(bool)
 
-	log.Printf("[INFO] Updating PingOne Schema Attribute: name %s", attributeName)
+	schemaAttribute, err := expandSchemaAttribute(d)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot expand Schema Attribute into SDK object",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
 
-	schemaAttribute := *pingone.NewSchemaAttribute(enabled, attributeName, attributeType) // SchemaAttribute |  (optional)
-	schemaAttribute.SetDisplayName(displayName)
-	schemaAttribute.SetDescription(description)
-	schemaAttribute.SetUnique(unique)
-	schemaAttribute.SetMultiValued(multivalued)
-	schemaAttribute.SetRequired(required)
+	log.Printf("[INFO] Updating PingOne Schema Attribute: name %s", schemaAttribute["name"])
 
-	_, r, err := api_client.ManagementAPIsSchemasApi.UpdateAttributePatch(ctx, envID, schemaID, attributeID).SchemaAttribute(schemaAttribute).Execute()
+	// The attribute is replaced rather than patched so that enumerated values and regex validation removed from the
+	// configuration are also removed from the attribute
+	r, err := executeRawRequest(ctx, api_client, http.MethodPut, "ManagementAPIsSchemasApiService.UpdateAttributePut", fmt.Sprintf("/v1/environments/%s/schemas/%s/attributes/%s", envID, schemaID, attributeID), schemaAttribute)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
-			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.UpdateAttributePatch``: %v", err),
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.UpdateAttributePut``: %v", err),
 			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
 		})
 
@@ -246,7 +354,7 @@ func resourceSchemaAttributeDelete(ctx context.Context, d *schema.ResourceData,
 func resourceSchemaAttributeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 3)
 
-	if len(attributes) != 2 {
+	if len(attributes) != 3 {
 		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/schemaID/attributeID\"", d.Id())
 	}
 
@@ -260,3 +368,289 @@ func resourceSchemaAttributeImport(ctx context.Context, d *schema.ResourceData,
 
 	return []*schema.ResourceData{d}, nil
 }
+
+func resourceSchemaAttributeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	attributeType := d.Get("type").(string)
+
+	if d.NewValueKnown("sub_attribute") {
+		_, hasSubAttributes := d.GetOk("sub_attribute")
+
+		if attributeType == "COMPLEX" && !hasSubAttributes {
+			return fmt.Errorf("at least one `sub_attribute` is required for COMPLEX attributes")
+		}
+
+		if attributeType != "COMPLEX" && hasSubAttributes {
+			return fmt.Errorf("`sub_attribute` can only be set on COMPLEX attributes, the attribute type is %s", attributeType)
+		}
+	}
+
+	if attributeType != "STRING" {
+		for _, k := range []string{"enumerated_values", "regex_validation"} {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf("`%s` can only be set on STRING attributes, the attribute type is %s", k, attributeType)
+			}
+		}
+	}
+
+	if !d.NewValueKnown("regex_validation") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("regex_validation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		regexValidation := v.([]interface{})[0].(map[string]interface{})
+
+		// The pattern has to match the whole value, so it is anchored before the example values are checked.  Patterns
+		// using Java syntax that Go doesn't support can't be checked, and have already been warned on by validation
+		pattern, err := regexp.Compile(fmt.Sprintf("^(?:%s)$", regexValidation["pattern"].(string)))
+		if err != nil {
+			log.Printf("[WARN] Cannot compile `regex_validation.pattern`, skipping the example value checks: %v", err)
+			return nil
+		}
+
+		for _, value := range regexValidation["values_pattern_should_match"].(*schema.Set).List() {
+			if !pattern.MatchString(value.(string)) {
+				return fmt.Errorf("`regex_validation.pattern` does not match the value \"%s\" in `values_pattern_should_match`", value.(string))
+			}
+		}
+
+		for _, value := range regexValidation["values_pattern_should_not_match"].(*schema.Set).List() {
+			if pattern.MatchString(value.(string)) {
+				return fmt.Errorf("`regex_validation.pattern` matches the value \"%s\" in `values_pattern_should_not_match`", value.(string))
+			}
+		}
+	}
+
+	return nil
+}
+
+// PingOne evaluates patterns as Java regular expressions, which support syntax that Go's RE2 engine doesn't, such as
+// lookarounds, backreferences and possessive quantifiers.  Only errors that Java would also raise are rejected
+func validateSchemaAttributeRegExp(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, ok := v.(string)
+	if !ok {
+		return diags
+	}
+
+	_, err := syntax.Parse(value, syntax.Perl)
+	if err == nil {
+		return diags
+	}
+
+	if e, ok := err.(*syntax.Error); ok {
+		switch e.Code {
+		case syntax.ErrMissingParen, syntax.ErrUnexpectedParen, syntax.ErrMissingBracket, syntax.ErrTrailingBackslash, syntax.ErrInvalidCharRange, syntax.ErrMissingRepeatArgument:
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid regular expression",
+				Detail:        fmt.Sprintf("The pattern \"%s\" is not a valid regular expression: %v\n", value, err),
+				AttributePath: path,
+			})
+
+			return diags
+		}
+	}
+
+	diags = append(diags, diag.Diagnostic{
+		Severity:      diag.Warning,
+		Summary:       "Regular expression can't be checked at plan time",
+		Detail:        fmt.Sprintf("The pattern \"%s\" uses syntax that can only be checked by PingOne (%v).  The example values in `values_pattern_should_match` and `values_pattern_should_not_match` won't be checked\n", value, err),
+		AttributePath: path,
+	})
+
+	return diags
+}
+
+// The SDK's SchemaAttribute model doesn't include sub-attributes, enumerated values or regex validation, so the attribute is built from the
+// SDK model and sent as an untyped body with those properties added
+func expandSchemaAttribute(d *schema.ResourceData) (map[string]interface{}, error) {
+
+	schemaAttribute := *pingone.NewSchemaAttribute(d.Get("enabled").(bool), d.Get("name").(string), d.Get("type").(string))
+	schemaAttribute.SetDisplayName(d.Get("display_name").(string))
+	schemaAttribute.SetDescription(d.Get("description").(string))
+	schemaAttribute.SetUnique(d.Get("unique").(bool))
+	schemaAttribute.SetMultiValued(d.Get("multivalued").(bool))
+	schemaAttribute.SetRequired(d.Get("required").(bool))
+
+	b, err := json.Marshal(schemaAttribute)
+	if err != nil {
+		return nil, err
+	}
+
+	item := make(map[string]interface{})
+	if err := json.Unmarshal(b, &item); err != nil {
+		return nil, err
+	}
+
+	if v, ok := d.GetOk("sub_attribute"); ok {
+		subAttributes := make([]interface{}, 0)
+		for _, v1 := range v.(*schema.Set).List() {
+			subAttribute := v1.(map[string]interface{})
+
+			value := map[string]interface{}{
+				"name":        subAttribute["name"].(string),
+				"type":        subAttribute["type"].(string),
+				"required":    subAttribute["required"].(bool),
+				"multiValued": subAttribute["multivalued"].(bool),
+			}
+
+			if v2, ok := subAttribute["display_name"].(string); ok && v2 != "" {
+				value["displayName"] = v2
+			}
+
+			subAttributes = append(subAttributes, value)
+		}
+
+		item["subAttributes"] = subAttributes
+	}
+
+	if v, ok := d.GetOk("enumerated_values"); ok {
+		enumeratedValues := make([]interface{}, 0)
+		for _, v1 := range v.(*schema.Set).List() {
+			enumeratedValue := v1.(map[string]interface{})
+
+			value := map[string]interface{}{
+				"value": enumeratedValue["value"].(string),
+			}
+
+			if v2, ok := enumeratedValue["description"].(string); ok && v2 != "" {
+				value["description"] = v2
+			}
+
+			enumeratedValues = append(enumeratedValues, value)
+		}
+
+		item["enumeratedValues"] = enumeratedValues
+	}
+
+	if v, ok := d.GetOk("regex_validation"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		regexValidation := v.([]interface{})[0].(map[string]interface{})
+
+		item["regexValidation"] = map[string]interface{}{
+			"pattern":                     regexValidation["pattern"].(string),
+			"requirements":                regexValidation["requirements"].(string),
+			"valuesPatternShouldMatch":    marshalInterfaceToString(regexValidation["values_pattern_should_match"].(*schema.Set).List()),
+			"valuesPatternShouldNotMatch": marshalInterfaceToString(regexValidation["values_pattern_should_not_match"].(*schema.Set).List()),
+		}
+	}
+
+	return item, nil
+}
+
+func flattenSchemaAttributeSubAttributes(in map[string]interface{}) []interface{} {
+
+	items := make([]interface{}, 0)
+
+	if v, ok := in["subAttributes"].([]interface{}); ok {
+		for _, v1 := range v {
+			subAttribute := v1.(map[string]interface{})
+
+			items = append(items, map[string]interface{}{
+				"name":         subAttribute["name"],
+				"display_name": subAttribute["displayName"],
+				"type":         subAttribute["type"],
+				"required":     subAttribute["required"],
+				"multivalued":  subAttribute["multiValued"],
+			})
+		}
+	}
+
+	return items
+}
+
+func flattenSchemaAttributeEnumeratedValues(in map[string]interface{}) []interface{} {
+
+	items := make([]interface{}, 0)
+
+	if v, ok := in["enumeratedValues"].([]interface{}); ok {
+		for _, v1 := range v {
+			enumeratedValue := v1.(map[string]interface{})
+
+			items = append(items, map[string]interface{}{
+				"value":       enumeratedValue["value"],
+				"description": enumeratedValue["description"],
+			})
+		}
+	}
+
+	return items
+}
+
+func flattenSchemaAttributeRegexValidation(in map[string]interface{}) []interface{} {
+
+	items := make([]interface{}, 0)
+
+	if v, ok := in["regexValidation"].(map[string]interface{}); ok {
+		items = append(items, map[string]interface{}{
+			"pattern":                         v["pattern"],
+			"requirements":                    v["requirements"],
+			"values_pattern_should_match":     v["valuesPatternShouldMatch"],
+			"values_pattern_should_not_match": v["valuesPatternShouldNotMatch"],
+		})
+	}
+
+	return items
+}
+
+// Reads every attribute in the schema, including the core and standard attributes present in every environment
+func readAllSchemaAttributes(ctx context.Context, api_client *pingone.APIClient, envID, schemaID string) ([]pingone.SchemaAttribute, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	items, listDiags := readAllSchemaAttributeItems(ctx, api_client, envID, schemaID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	attributes := make([]pingone.SchemaAttribute, 0)
+
+	if err := decodeItems(items, &attributes); err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal schema attributes json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	return attributes, diags
+}
+
+// Reads every attribute in the schema as raw objects, for the properties the SDK model doesn't carry such as
+// `subAttributes`
+func readAllSchemaAttributeItems(ctx context.Context, api_client *pingone.APIClient, envID, schemaID string) ([]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	_, r, err := api_client.ManagementAPIsSchemasApi.ReadAllSchemaAttributes(ctx, envID, schemaID).Execute()
+	if err != nil {
+		// The User schema is also read by the plan time reference check, which is skipped if the schema can't be read
+		detail := fmt.Sprintf("Full error: %v\n", err)
+		if r != nil {
+			detail = fmt.Sprintf("Full HTTP response: %v\n", r.Body)
+		}
+
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.ReadAllSchemaAttributes``: %v", err),
+			Detail:   detail,
+		})
+
+		return nil, diags
+	}
+
+	items, err := readAllPages(ctx, api_client, r, "attributes")
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot read every page of the schema attributes json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return nil, diags
+	}
+
+	return items, diags
+}
@@ -0,0 +1,507 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceSchemaAttributeOverride() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSchemaAttributeOverrideCreate,
+		ReadContext:   resourceSchemaAttributeOverrideRead,
+		UpdateContext: resourceSchemaAttributeOverrideUpdate,
+		DeleteContext: resourceSchemaAttributeOverrideDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSchemaAttributeOverrideImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"schema_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^.]+(\.[^.]+)?$`), "must be an attribute name, or a sub-attribute name in the format `attribute.subAttribute`"),
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"unique": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"multivalued": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ldap_attribute": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"schema_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"original": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSchemaAttributeOverrideCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	schemaID := d.Get("schema_id").(string)
+	attributeName := d.Get("name").(string)
+	parentName, subAttributeName := splitSchemaAttributeName(attributeName)
+
+	log.Printf("[INFO] Adopting PingOne Schema Attribute: name %s", attributeName)
+
+	attributes, listDiags := readAllSchemaAttributes(ctx, api_client, envID, schemaID)
+	diags = append(diags, listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var parent *pingone.SchemaAttribute
+	for i := range attributes {
+		if attributes[i].GetName() == parentName {
+			parent = &attributes[i]
+			break
+		}
+	}
+
+	if parent == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot find schema attribute with name %s", parentName),
+			Detail:   "The attribute must already exist in the schema.  Use the pingone_schema_attribute resource to create custom attributes\n",
+		})
+
+		return diags
+	}
+
+	if parent.GetSchemaType() == "CUSTOM" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Schema attribute %s is a custom attribute", parentName),
+			Detail:   "Custom attributes should be managed with the pingone_schema_attribute resource\n",
+		})
+
+		return diags
+	}
+
+	_, resp, r, err := readSchemaAttributeOverride(ctx, api_client, envID, schemaID, parent.GetId(), subAttributeName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.ReadOneAttribute``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	if resp == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot find sub-attribute %s of schema attribute %s", subAttributeName, parentName),
+			Detail:   "The sub-attribute must already exist in the schema\n",
+		})
+
+		return diags
+	}
+
+	// The attribute's values at adoption are kept so that they can be restored when the override is destroyed
+	original := flattenSchemaAttributeOverrideValues(resp)
+
+	d.SetId(schemaAttributeOverrideID(parent.GetId(), subAttributeName))
+	d.Set("original", []interface{}{original})
+
+	values := map[string]interface{}{
+		"display_name": original["display_name"],
+		"description":  original["description"],
+		"enabled":      original["enabled"],
+		"required":     original["required"],
+	}
+
+	if v, ok := d.GetOk("display_name"); ok {
+		values["display_name"] = v.(string)
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		values["description"] = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("enabled"); ok {
+		values["enabled"] = v.(bool)
+	}
+
+	if v, ok := d.GetOkExists("required"); ok {
+		values["required"] = v.(bool)
+	}
+
+	diags = append(diags, patchSchemaAttributeOverride(ctx, p1Client, envID, schemaID, parent.GetId(), subAttributeName, values)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceSchemaAttributeOverrideRead(ctx, d, meta)
+}
+
+func resourceSchemaAttributeOverrideRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	attributeID, subAttributeName := splitSchemaAttributeOverrideID(d.Id())
+	envID := d.Get("environment_id").(string)
+	schemaID := d.Get("schema_id").(string)
+
+	attribute, resp, r, err := readSchemaAttributeOverride(ctx, api_client, envID, schemaID, attributeID, subAttributeName)
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Schema Attribute %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.ReadOneAttribute``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	if resp == nil {
+		log.Printf("[INFO] PingOne Schema Attribute %s no longer has sub-attribute %s", attributeID, subAttributeName)
+		d.SetId("")
+		return nil
+	}
+
+	name, _ := attribute["name"].(string)
+	if subAttributeName != "" {
+		name = fmt.Sprintf("%s.%s", name, subAttributeName)
+	}
+
+	d.Set("name", name)
+	for k, v := range flattenSchemaAttributeOverrideValues(resp) {
+		d.Set(k, v)
+	}
+	d.Set("type", resp["type"])
+	d.Set("unique", resp["unique"])
+	d.Set("multivalued", resp["multiValued"])
+	d.Set("ldap_attribute", resp["ldapAttribute"])
+	d.Set("schema_type", attribute["schemaType"])
+
+	return diags
+}
+
+func resourceSchemaAttributeOverrideUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	attributeID, subAttributeName := splitSchemaAttributeOverrideID(d.Id())
+	envID := d.Get("environment_id").(string)
+	schemaID := d.Get("schema_id").(string)
+
+	log.Printf("[INFO] Updating PingOne Schema Attribute Override: name %s", d.Get("name").(string))
+
+	values := map[string]interface{}{
+		"display_name": d.Get("display_name").(string),
+		"description":  d.Get("description").(string),
+		"enabled":      d.Get("enabled").(bool),
+		"required":     d.Get("required").(bool),
+	}
+
+	diags = append(diags, patchSchemaAttributeOverride(ctx, p1Client, envID, schemaID, attributeID, subAttributeName, values)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceSchemaAttributeOverrideRead(ctx, d, meta)
+}
+
+func resourceSchemaAttributeOverrideDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	attributeID, subAttributeName := splitSchemaAttributeOverrideID(d.Id())
+	envID := d.Get("environment_id").(string)
+	schemaID := d.Get("schema_id").(string)
+
+	// Built-in attributes can't be deleted, so the values the attribute had when it was adopted are restored instead
+	v, ok := d.GetOk("original")
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Schema attribute %s has no original values to restore", d.Get("name").(string)),
+			Detail:   "The override has been removed from state and the attribute has been left unchanged\n",
+		})
+
+		return diags
+	}
+
+	original := v.([]interface{})[0].(map[string]interface{})
+
+	log.Printf("[INFO] Restoring PingOne Schema Attribute: name %s", d.Get("name").(string))
+
+	diags = append(diags, patchSchemaAttributeOverride(ctx, p1Client, envID, schemaID, attributeID, subAttributeName, original)...)
+
+	return diags
+}
+
+func resourceSchemaAttributeOverrideImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 4)
+
+	if len(attributes) != 3 && len(attributes) != 4 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/schemaID/attributeID\" or \"envID/schemaID/attributeID/subAttributeName\"", d.Id())
+	}
+
+	envID, schemaID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.Set("schema_id", schemaID)
+	d.SetId(strings.Join(attributes[2:], "/"))
+
+	resourceSchemaAttributeOverrideRead(ctx, d, meta)
+
+	if d.Id() == "" {
+		return nil, fmt.Errorf("cannot find schema attribute %s", strings.Join(attributes[2:], "."))
+	}
+
+	// The values at import are taken as the values to restore on destroy
+	d.Set("original", []interface{}{
+		map[string]interface{}{
+			"display_name": d.Get("display_name").(string),
+			"description":  d.Get("description").(string),
+			"enabled":      d.Get("enabled").(bool),
+			"required":     d.Get("required").(bool),
+		},
+	})
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// Overrides of a sub-attribute are identified by the parent attribute ID and the sub-attribute name, e.g.
+// `attributeID/given`, so that overrides of two sub-attributes of the same attribute never share an ID
+func schemaAttributeOverrideID(attributeID, subAttributeName string) string {
+	if subAttributeName == "" {
+		return attributeID
+	}
+
+	return fmt.Sprintf("%s/%s", attributeID, subAttributeName)
+}
+
+func splitSchemaAttributeOverrideID(id string) (string, string) {
+	parts := strings.SplitN(id, "/", 2)
+
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// Splits a dotted name such as `name.given` into the top-level attribute name and the sub-attribute name
+func splitSchemaAttributeName(name string) (string, string) {
+	parts := strings.SplitN(name, ".", 2)
+
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// The SDK schema attribute model doesn't carry `subAttributes`, so the attribute is read from the raw response body.
+// The attribute is returned along with the object being overridden, which is the attribute itself or the named
+// sub-attribute.  The object is nil if the sub-attribute doesn't exist
+func readSchemaAttributeOverride(ctx context.Context, api_client *pingone.APIClient, envID, schemaID, attributeID, subAttributeName string) (map[string]interface{}, map[string]interface{}, *http.Response, error) {
+	_, r, err := api_client.ManagementAPIsSchemasApi.ReadOneAttribute(ctx, envID, schemaID, attributeID).Execute()
+	if err != nil {
+		return nil, nil, r, err
+	}
+
+	attribute, err := unmarshalResponseBody(r)
+	if err != nil {
+		return nil, nil, r, err
+	}
+
+	if subAttributeName == "" {
+		return attribute, attribute, r, nil
+	}
+
+	if v, ok := attribute["subAttributes"].([]interface{}); ok {
+		for _, v1 := range v {
+			if subAttribute, ok := v1.(map[string]interface{}); ok && subAttribute["name"] == subAttributeName {
+				return attribute, subAttribute, r, nil
+			}
+		}
+	}
+
+	return attribute, nil, r, nil
+}
+
+func flattenSchemaAttributeOverrideValues(in map[string]interface{}) map[string]interface{} {
+	displayName, _ := in["displayName"].(string)
+	description, _ := in["description"].(string)
+	required, _ := in["required"].(bool)
+
+	// Sub-attributes don't always carry their own enabled flag, in which case they are enabled
+	enabled, ok := in["enabled"].(bool)
+	if !ok {
+		enabled = true
+	}
+
+	return map[string]interface{}{
+		"display_name": displayName,
+		"description":  description,
+		"enabled":      enabled,
+		"required":     required,
+	}
+}
+
+// Every mutable property is sent, including empty display names and descriptions, so that the original values of an
+// attribute are fully restored.  Sub-attributes are patched by sending the parent attribute's `subAttributes` with the
+// named sub-attribute's values replaced.  The read and patch are made under a lock on the attribute, as overrides of
+// other sub-attributes of the same attribute are applied in parallel and would otherwise write back a stale list
+func patchSchemaAttributeOverride(ctx context.Context, p1Client *p1Client, envID, schemaID, attributeID, subAttributeName string, values map[string]interface{}) diag.Diagnostics {
+	api_client := p1Client.APIClient
+	var diags diag.Diagnostics
+
+	lock := p1Client.schemaAttributeMutex(attributeID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	attribute, subAttribute, r, err := readSchemaAttributeOverride(ctx, api_client, envID, schemaID, attributeID, subAttributeName)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.ReadOneAttribute``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	if subAttribute == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Cannot find sub-attribute %s of schema attribute %s", subAttributeName, attributeID),
+			Detail:   "The sub-attribute must exist in the schema\n",
+		})
+
+		return diags
+	}
+
+	subAttribute["displayName"] = values["display_name"].(string)
+	subAttribute["description"] = values["description"].(string)
+	subAttribute["enabled"] = values["enabled"].(bool)
+	subAttribute["required"] = values["required"].(bool)
+
+	body := map[string]interface{}{
+		"name": attribute["name"],
+		"type": attribute["type"],
+	}
+
+	if subAttributeName == "" {
+		for _, k := range []string{"displayName", "description", "enabled", "required"} {
+			body[k] = attribute[k]
+		}
+	} else {
+		body["enabled"] = attribute["enabled"]
+		body["subAttributes"] = attribute["subAttributes"]
+	}
+
+	r, err = executeRawRequest(ctx, api_client, http.MethodPatch, "ManagementAPIsSchemasApiService.UpdateAttributePatch", fmt.Sprintf("/v1/environments/%s/schemas/%s/attributes/%s", envID, schemaID, attributeID), body)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSchemasApi.UpdateAttributePatch``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	return diags
+}
@@ -0,0 +1,180 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceTrustedEmailAddress() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrustedEmailAddressCreate,
+		ReadContext:   resourceTrustedEmailAddressRead,
+		DeleteContext: resourceTrustedEmailAddressDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTrustedEmailAddressImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email_domain_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"email_address": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTrustedEmailAddressCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	emailDomainID := d.Get("email_domain_id").(string)
+	emailAddress := d.Get("email_address").(string)
+
+	log.Printf("[INFO] Creating PingOne Trusted Email Address: email %s", emailAddress)
+
+	trustedEmail := map[string]interface{}{
+		"emailAddress": emailAddress,
+	}
+
+	r, err := api_client.ManagementAPIsNotificationsTrustedEmailAddressesApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdTrustedEmailsPost(ctx, envID, emailDomainID).Body(trustedEmail).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailAddressesApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdTrustedEmailsPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal trusted email address json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceTrustedEmailAddressRead(ctx, d, meta)
+}
+
+func resourceTrustedEmailAddressRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	trustedEmailID := d.Id()
+	envID := d.Get("environment_id").(string)
+	emailDomainID := d.Get("email_domain_id").(string)
+
+	r, err := api_client.ManagementAPIsNotificationsTrustedEmailAddressesApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdTrustedEmailsTrustedEmailIdGet(ctx, envID, emailDomainID, trustedEmailID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Trusted Email Address %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailAddressesApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdTrustedEmailsTrustedEmailIdGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal trusted email address json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("email_address", resp["emailAddress"])
+	d.Set("status", resp["status"])
+
+	return diags
+}
+
+func resourceTrustedEmailAddressDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	emailDomainID := d.Get("email_domain_id").(string)
+
+	trustedEmailID := d.Id()
+
+	_, err := api_client.ManagementAPIsNotificationsTrustedEmailAddressesApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdTrustedEmailsTrustedEmailIdDelete(ctx, envID, emailDomainID, trustedEmailID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailAddressesApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdTrustedEmailsTrustedEmailIdDelete``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceTrustedEmailAddressImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/emailDomainID/trustedEmailID\"", d.Id())
+	}
+
+	envID, emailDomainID, trustedEmailID := attributes[0], attributes[1], attributes[2]
+
+	d.Set("environment_id", envID)
+	d.Set("email_domain_id", emailDomainID)
+	d.SetId(trustedEmailID)
+
+	resourceTrustedEmailAddressRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
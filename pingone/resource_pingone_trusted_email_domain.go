@@ -0,0 +1,336 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceTrustedEmailDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTrustedEmailDomainCreate,
+		ReadContext:   resourceTrustedEmailDomainRead,
+		DeleteContext: resourceTrustedEmailDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTrustedEmailDomainImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ownership_record": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"dkim_record": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTrustedEmailDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+	domainName := d.Get("domain_name").(string)
+
+	log.Printf("[INFO] Creating PingOne Trusted Email Domain: domain %s", domainName)
+
+	emailDomain := map[string]interface{}{
+		"domainName": domainName,
+	}
+
+	r, err := api_client.ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsPost(ctx, envID).Body(emailDomain).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal trusted email domain json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceTrustedEmailDomainRead(ctx, d, meta)
+}
+
+func resourceTrustedEmailDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	emailDomainID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	r, err := api_client.ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdGet(ctx, envID, emailDomainID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Trusted Email Domain %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal trusted email domain json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("domain_name", resp["domainName"])
+
+	rOwnership, err := api_client.ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdOwnershipGet(ctx, envID, emailDomainID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdOwnershipGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", rOwnership.Body),
+		})
+
+		return diags
+	}
+
+	respOwnership, err := unmarshalResponseBody(rOwnership)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal trusted email domain ownership json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("ownership_record", flattenTrustedEmailDomainOwnership(respOwnership, d.Get("domain_name").(string)))
+
+	rDKIM, err := api_client.ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdDkimGet(ctx, envID, emailDomainID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdDkimGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", rDKIM.Body),
+		})
+
+		return diags
+	}
+
+	respDKIM, err := unmarshalResponseBody(rDKIM)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal trusted email domain DKIM json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("dkim_record", flattenTrustedEmailDomainDKIM(respDKIM))
+
+	return diags
+}
+
+func resourceTrustedEmailDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	emailDomainID := d.Id()
+
+	_, err := api_client.ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdDelete(ctx, envID, emailDomainID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsNotificationsTrustedEmailDomainsApi.V1EnvironmentsEnvIDEmailDomainsEmailDomainIdDelete``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceTrustedEmailDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/emailDomainID\"", d.Id())
+	}
+
+	envID, emailDomainID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.SetId(emailDomainID)
+
+	resourceTrustedEmailDomainRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// The ownership TXT record is always created at the apex of the trusted domain, once per region
+func flattenTrustedEmailDomainOwnership(in map[string]interface{}, domainName string) []interface{} {
+
+	items := make([]interface{}, 0)
+
+	regions, ok := in["regions"].([]interface{})
+	if !ok {
+		return items
+	}
+
+	for _, v := range regions {
+		region := v.(map[string]interface{})
+
+		values := make([]interface{}, 0)
+		if regionValues, ok := region["values"].([]interface{}); ok {
+			values = append(values, regionValues...)
+		}
+
+		items = append(items, map[string]interface{}{
+			"type":   in["type"],
+			"region": region["name"],
+			"status": region["status"],
+			"name":   domainName,
+			"values": values,
+		})
+	}
+
+	return items
+}
+
+func flattenTrustedEmailDomainDKIM(in map[string]interface{}) []interface{} {
+
+	items := make([]interface{}, 0)
+
+	regions, ok := in["regions"].([]interface{})
+	if !ok {
+		return items
+	}
+
+	for _, v := range regions {
+		region := v.(map[string]interface{})
+
+		tokens, ok := region["tokens"].([]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, t := range tokens {
+			token := t.(map[string]interface{})
+
+			items = append(items, map[string]interface{}{
+				"type":   in["type"],
+				"region": region["name"],
+				"status": region["status"],
+				"name":   token["key"],
+				"value":  token["value"],
+			})
+		}
+	}
+
+	return items
+}
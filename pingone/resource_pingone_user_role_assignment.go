@@ -21,6 +21,8 @@ func resourceUserRoleAssignment() *schema.Resource {
 			StateContext: resourceUserRoleAssignmentImport,
 		},
 
+		CustomizeDiff: resourceRoleAssignmentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"environment_id": {
 				Type:     schema.TypeString,
@@ -0,0 +1,325 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/patrickcping/pingone-go"
+)
+
+func resourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWebhookCreate,
+		ReadContext:   resourceWebhookRead,
+		UpdateContext: resourceWebhookUpdate,
+		DeleteContext: resourceWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWebhookImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"http_endpoint_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"http_endpoint_headers": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"verify_tls_certificates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ACTIVITY", "SPLUNK", "NEWRELIC"}, false),
+			},
+			"filter_options": {
+				Type:     schema.TypeSet,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"included_action_types": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"included_population_ids": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	subscription := expandWebhook(d)
+
+	log.Printf("[INFO] Creating PingOne Webhook: name %s", subscription["name"])
+
+	r, err := api_client.ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsPost(ctx, envID).Body(subscription).Execute()
+	if (err != nil) || (r.StatusCode != 201) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsPost``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal webhook json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.SetId(resp["id"].(string))
+
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	subscriptionID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	r, err := api_client.ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsSubscriptionIDGet(ctx, envID, subscriptionID).Execute()
+	if err != nil {
+
+		if r.StatusCode == 404 {
+			log.Printf("[INFO] PingOne Webhook %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsSubscriptionIDGet``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	resp, err := unmarshalResponseBody(r)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Cannot unmarshal webhook json response",
+			Detail:   fmt.Sprintf("Full error: %v\n", err),
+		})
+
+		return diags
+	}
+
+	d.Set("name", resp["name"])
+	d.Set("enabled", resp["enabled"])
+	d.Set("verify_tls_certificates", resp["verifyTlsCertificates"])
+	d.Set("format", resp["format"])
+
+	if v, ok := resp["httpEndpoint"].(map[string]interface{}); ok {
+		d.Set("http_endpoint_url", v["url"])
+
+		// The platform may not echo header values back, so only overwrite state when they are returned
+		if headers, ok := v["headers"].(map[string]interface{}); ok {
+			d.Set("http_endpoint_headers", headers)
+		}
+	}
+
+	if v, ok := resp["filterOptions"].(map[string]interface{}); ok {
+		d.Set("filter_options", flattenWebhookFilterOptions(v))
+	}
+
+	return diags
+}
+
+func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	subscriptionID := d.Id()
+	envID := d.Get("environment_id").(string)
+
+	subscription := expandWebhook(d)
+
+	log.Printf("[INFO] Updating PingOne Webhook: name %s", subscription["name"])
+
+	r, err := api_client.ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsSubscriptionIDPut(ctx, envID, subscriptionID).Body(subscription).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsSubscriptionIDPut``: %v", err),
+			Detail:   fmt.Sprintf("Full HTTP response: %v\n", r.Body),
+		})
+
+		return diags
+	}
+
+	return resourceWebhookRead(ctx, d, meta)
+}
+
+func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	p1Client := meta.(*p1Client)
+	api_client := p1Client.APIClient
+	ctx = context.WithValue(ctx, pingone.ContextServerVariables, map[string]string{
+		"suffix": p1Client.regionSuffix,
+	})
+	var diags diag.Diagnostics
+
+	envID := d.Get("environment_id").(string)
+
+	subscriptionID := d.Id()
+
+	_, err := api_client.ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsSubscriptionIDDelete(ctx, envID, subscriptionID).Execute()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("Error when calling `ManagementAPIsSubscriptionsWebhooksApi.V1EnvironmentsEnvIDSubscriptionsSubscriptionIDDelete``: %v", err),
+		})
+
+		return diags
+	}
+
+	return nil
+}
+
+func resourceWebhookImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"envID/webhookID\"", d.Id())
+	}
+
+	envID, subscriptionID := attributes[0], attributes[1]
+
+	d.Set("environment_id", envID)
+	d.SetId(subscriptionID)
+
+	resourceWebhookRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandWebhook(d *schema.ResourceData) map[string]interface{} {
+
+	httpEndpoint := map[string]interface{}{
+		"url": d.Get("http_endpoint_url").(string),
+	}
+
+	if v, ok := d.GetOk("http_endpoint_headers"); ok {
+		httpEndpoint["headers"] = v.(map[string]interface{})
+	}
+
+	filterOptions := map[string]interface{}{}
+
+	if v, ok := d.GetOk("filter_options"); ok {
+		filterOptionsIn := v.(*schema.Set).List()[0].(map[string]interface{})
+
+		filterOptions["includedActionTypes"] = marshalInterfaceToString(filterOptionsIn["included_action_types"].([]interface{}))
+
+		if populations := filterOptionsIn["included_population_ids"].([]interface{}); len(populations) > 0 {
+			populationItems := make([]map[string]interface{}, 0, len(populations))
+			for _, population := range populations {
+				populationItems = append(populationItems, map[string]interface{}{
+					"id": population.(string),
+				})
+			}
+
+			filterOptions["includedPopulations"] = populationItems
+		}
+	}
+
+	subscription := map[string]interface{}{
+		"name":                  d.Get("name").(string),
+		"enabled":               d.Get("enabled").(bool),
+		"verifyTlsCertificates": d.Get("verify_tls_certificates").(bool),
+		"format":                d.Get("format").(string),
+		"httpEndpoint":          httpEndpoint,
+		"filterOptions":         filterOptions,
+	}
+
+	return subscription
+}
+
+func flattenWebhookFilterOptions(in map[string]interface{}) []interface{} {
+
+	actionTypeItems := make([]interface{}, 0)
+	if v, ok := in["includedActionTypes"].([]interface{}); ok {
+		actionTypeItems = append(actionTypeItems, v...)
+	}
+
+	populationItems := make([]interface{}, 0)
+	if v, ok := in["includedPopulations"].([]interface{}); ok {
+		for _, population := range v {
+			populationItems = append(populationItems, population.(map[string]interface{})["id"])
+		}
+	}
+
+	items := make([]interface{}, 0)
+	items = append(items, map[string]interface{}{
+		"included_action_types":   actionTypeItems,
+		"included_population_ids": populationItems,
+	})
+
+	return items
+}
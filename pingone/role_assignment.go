@@ -0,0 +1,48 @@
+package pingone
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Plan time check, shared by the role assignment resources, that the scope type is one the role can be assigned to
+func resourceRoleAssignmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+
+	if !d.NewValueKnown("role_id") || !d.NewValueKnown("scope_type") {
+		return nil
+	}
+
+	roleID := d.Get("role_id").(string)
+	scopeType := d.Get("scope_type").(string)
+
+	roles, err := meta.(*p1Client).getRoles(ctx)
+	if err != nil {
+		log.Printf("[WARN] Cannot read the PingOne roles, skipping the scope type check: %v", err)
+		return nil
+	}
+
+	for _, role := range roles {
+		if role.GetId() != roleID {
+			continue
+		}
+
+		applicableTo := role.GetApplicableTo()
+		if len(applicableTo) == 0 {
+			return nil
+		}
+
+		for _, v := range applicableTo {
+			if v == scopeType {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("the role %s (%s) cannot be assigned with scope type %s, the role's allowed scope types are: %s", role.GetName(), roleID, scopeType, strings.Join(applicableTo, ", "))
+	}
+
+	return nil
+}
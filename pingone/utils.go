@@ -1,7 +1,16 @@
 package pingone
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/patrickcping/pingone-go"
 )
 
 func HashByMapKey(key string) func(v interface{}) int {
@@ -19,3 +28,172 @@ func marshalInterfaceToString(v []interface{}) []string {
 
 	return w
 }
+
+// Uncleansed SDK functions (V1Environments...) only return the raw HTTP response, so the body has to be decoded here
+func unmarshalResponseBody(r *http.Response) (map[string]interface{}, error) {
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	body := make(map[string]interface{})
+	if err := json.Unmarshal(b, &body); err != nil {
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// Quotes a value for use in a SCIM filter expression
+func scimFilterValue(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+
+	return fmt.Sprintf("\"%s\"", v)
+}
+
+const readAllPagesLimit = 1000
+
+// The SDK list functions only return the first page, and the SDK's EntityArray doesn't carry `_links`.  Every page is
+// read from the raw body of the first page's response, following the `_links.next` href with the SDK's own HTTP client
+// and headers, and the raw items of the named `_embedded` property are returned for the caller to decode
+func readAllPages(ctx context.Context, api_client *pingone.APIClient, r *http.Response, key string) ([]interface{}, error) {
+	body, err := unmarshalResponseBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0)
+	read := make(map[string]bool)
+
+	for {
+		items = append(items, embeddedItems(body, key)...)
+
+		next := nextPageLinkFromBody(body)
+		if next == "" {
+			return items, nil
+		}
+
+		// A `next` link that repeats, or a list that never ends, would otherwise be followed forever
+		if read[next] {
+			return nil, fmt.Errorf("the next page link of %s repeats a page already read: %s", key, next)
+		}
+
+		if len(read) >= readAllPagesLimit {
+			return nil, fmt.Errorf("more than %d pages of %s were returned", readAllPagesLimit, key)
+		}
+
+		read[next] = true
+
+		_, b, err := executeRequest(ctx, api_client, http.MethodGet, next, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading the next page of %s: %v", key, err)
+		}
+
+		body = make(map[string]interface{})
+		if err := json.Unmarshal(b, &body); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func embeddedItems(body map[string]interface{}, key string) []interface{} {
+	if embedded, ok := body["_embedded"].(map[string]interface{}); ok {
+		if v, ok := embedded[key].([]interface{}); ok {
+			return v
+		}
+	}
+
+	return []interface{}{}
+}
+
+func nextPageLinkFromBody(body map[string]interface{}) string {
+	if links, ok := body["_links"].(map[string]interface{}); ok {
+		if next, ok := links["next"].(map[string]interface{}); ok {
+			if href, ok := next["href"].(string); ok {
+				return href
+			}
+		}
+	}
+
+	return ""
+}
+
+// Decodes raw list items into a slice of SDK models
+func decodeItems(items []interface{}, out interface{}) error {
+	b, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, out)
+}
+
+// Some SDK models don't yet carry every property of the API object they represent.  Where the SDK function takes a
+// typed body, requests that need those properties are sent with an untyped body to the SDK operation's own server URL
+func executeRawRequest(ctx context.Context, api_client *pingone.APIClient, method, operation, path string, body interface{}) (*http.Response, error) {
+
+	basePath, err := api_client.GetConfig().ServerURLWithContext(ctx, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	r, _, err := executeRequest(ctx, api_client, method, basePath+path, body)
+
+	return r, err
+}
+
+// Sends a request using the SDK's own HTTP client and headers.  The response body is returned, and also reset on the
+// response so it can be read again
+func executeRequest(ctx context.Context, api_client *pingone.APIClient, method, url string, body interface{}) (*http.Response, []byte, error) {
+
+	cfg := api_client.GetConfig()
+
+	var reqBody *bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, nil, err
+		}
+		reqBody = bytes.NewBuffer(b)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, v := range cfg.DefaultHeader {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("User-Agent", cfg.UserAgent)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	r, err := httpClient.Do(req)
+	if err != nil {
+		return r, nil, err
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return r, nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewBuffer(b))
+
+	if r.StatusCode >= 300 {
+		return r, b, fmt.Errorf("%s", r.Status)
+	}
+
+	return r, b, nil
+}